@@ -55,3 +55,26 @@ func TestGroupBy(t *testing.T) {
 		)
 	})
 }
+
+func BenchmarkGroupBy(b *testing.B) {
+	items := make([]int, 100_000)
+	for i := range items {
+		items[i] = i
+	}
+
+	b.Run("grouping 100k items into 100k groups", func(b *testing.B) {
+		b.ReportAllocs()
+
+		for n := 0; n < b.N; n++ {
+			_ = collections.GroupBy(items, func(item int) int { return item })
+		}
+	})
+
+	b.Run("grouping 100k items into 10 groups", func(b *testing.B) {
+		b.ReportAllocs()
+
+		for n := 0; n < b.N; n++ {
+			_ = collections.GroupBy(items, func(item int) int { return item % 10 })
+		}
+	})
+}
@@ -37,7 +37,7 @@ func ReduceRight[Type any](collection []Type, reducer func(Type, Type) Type) Typ
 	}
 
 	result := collection[len(collection)-1]
-	for i := len(collection) - 2; i >= 0; i-- { //nolint:gomnd // 2 -> first-to-last position
+	for i := len(collection) - 2; i >= 0; i-- { //nolint:mnd // 2 -> first-to-last position
 		result = reducer(collection[i], result)
 	}
 
@@ -0,0 +1,42 @@
+package collections
+
+import "iter"
+
+// MapSeq returns an iter.Seq yielding the result of calling the provided mapper
+// function on each element in the given collection, in order. Unlike Map, the
+// mapper is only called as elements are asked for, so that ranging over a few
+// of them does not map the whole collection. See also: Map.
+func MapSeq[From any, To any](collection []From, mapper func(it From) To) iter.Seq[To] {
+	return func(yield func(To) bool) {
+		for _, element := range collection {
+			if !yield(mapper(element)) {
+				return
+			}
+		}
+	}
+}
+
+// FilterSeq returns an iter.Seq yielding the elements in the given collection
+// for which the provided predicate returns true, in order, without
+// constructing a new slice. See also: Filter.
+func FilterSeq[Type any](collection []Type, predicate func(Type) bool) iter.Seq[Type] {
+	return func(yield func(Type) bool) {
+		for _, element := range collection {
+			if predicate(element) && !yield(element) {
+				return
+			}
+		}
+	}
+}
+
+// ZipSeq returns an iter.Seq2 yielding pairs of elements of the two given
+// collections, up to the end of the smaller one. See also: Zip.
+func ZipSeq[A, B any](a []A, b []B) iter.Seq2[A, B] {
+	return func(yield func(A, B) bool) {
+		for i := 0; i < len(a) && i < len(b); i++ {
+			if !yield(a[i], b[i]) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package collections_test
+
+import (
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/gtramontina/go-extlib/collections"
+	"github.com/gtramontina/go-extlib/testing/assert"
+)
+
+func TestSeq(t *testing.T) {
+	t.Run("maps elements lazily", func(t *testing.T) {
+		calls := 0
+		double := func(i int) int {
+			calls++
+
+			return i * 2
+		}
+
+		assert.DeepEqual(t, slices.Collect(collections.MapSeq([]int{}, double)), []int(nil))
+		assert.DeepEqual(t, slices.Collect(collections.MapSeq([]int{1, 2, 3}, double)), []int{2, 4, 6})
+
+		calls = 0
+		for doubled := range collections.MapSeq([]int{1, 2, 3}, double) {
+			assert.Eq(t, doubled, 2)
+
+			break
+		}
+
+		assert.Eq(t, calls, 1)
+	})
+
+	t.Run("filters elements lazily", func(t *testing.T) {
+		even := func(i int) bool { return i%2 == 0 }
+		assert.DeepEqual(t, slices.Collect(collections.FilterSeq([]int{1, 3}, even)), []int(nil))
+		assert.DeepEqual(t, slices.Collect(collections.FilterSeq([]int{1, 2, 3, 4}, even)), []int{2, 4})
+
+		var first []int
+		for element := range collections.FilterSeq([]int{1, 2, 3, 4}, even) {
+			first = append(first, element)
+
+			break
+		}
+
+		assert.DeepEqual(t, first, []int{2})
+	})
+
+	t.Run("zips elements up to the end of the smaller collection", func(t *testing.T) {
+		assert.DeepEqual(t, maps.Collect(collections.ZipSeq([]string{"a", "b", "c"}, []int{1, 2})), map[string]int{"a": 1, "b": 2})
+		assert.DeepEqual(t, maps.Collect(collections.ZipSeq([]string{}, []int{1})), map[string]int{})
+	})
+}
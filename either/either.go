@@ -1,84 +1,34 @@
 package either
 
-import "github.com/gtramontina/go-extlib/maybe"
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/gtramontina/go-extlib/maybe"
+)
 
 // Either is a container for a value of one of two possible types (a disjoint
-// union): Left or Right.
-type Either[L any, R any] interface {
-	// seal is used internally as a way of limiting external implementations. It
-	// marks the container as being in one of the two possible states.
-	seal() string
-
-	// Equals checks if the container is equal to another container of the
-	// same type.
-	Equals(Either[L, R]) bool
-
-	// String returns a string representation of the container.
-	String() string
-
-	// IsLeft returns true if the container is in the Left state. See also:
-	// IsRight.
-	IsLeft() bool
-
-	// IsRight returns true if the container is in the Right state. See also:
-	// IsLeft.
-	IsRight() bool
-
-	// Left returns a maybe.Maybe representation of the container. If it is in
-	// the Left state, the value is returned wrapped in a maybe.Some. If it is
-	// in the Right state, a maybe.None is returned. See also: LeftOr,
-	// LeftOrElse, Right, RightOr, RightOrElse.
-	Left() maybe.Maybe[L]
-
-	// LeftOr returns the value if the container is in the Left state, or the
-	// given default value. See also: Left, LeftOrElse, Right, RightOr,
-	// RightOrElse.
-	LeftOr(L) L
-
-	// LeftOrElse returns the value if the container is in the Left state, or
-	// the result of calling the given function. See also: Left, LeftOr, Right,
-	// RightOr, RightOrElse.
-	LeftOrElse(func() L) L
-
-	// Right returns a maybe.Maybe representation of the container. If it is in
-	// the Right state, the value is returned wrapped in a maybe.Some. If it is
-	// in the Left state, a maybe.None is returned. See also: Left, LeftOr,
-	// LeftOrElse, RightOr, RightOrElse.
-	Right() maybe.Maybe[R]
-
-	// RightOr returns the value if the container is in the Right state, or the
-	// given default value. See also: Left, LeftOr, LeftOrElse, Right,
-	// RightOrElse.
-	RightOr(R) R
-
-	// RightOrElse returns the value if the container is in the Right state, or
-	// the result of calling the given function. See also: Left, LeftOr,
-	// LeftOrElse, Right, RightOr.
-	RightOrElse(func() R) R
-
-	// UnwrapLeft returns the value if the container is in the Left state, or
-	// panics. See also: UnwrapRight.
-	UnwrapLeft() L
-
-	// UnwrapRight returns the value if the container is in the Right state, or
-	// panics. See also: UnwrapLeft.
-	UnwrapRight() R
-
-	// Flip returns a new container with the state of the current container
-	// reversed.
-	Flip() Either[R, L]
+// union): Left or Right. Its zero value is Left, holding the zero value of L.
+type Either[L any, R any] struct {
+	left    L
+	right   R
+	isRight bool
 }
 
 // Left returns a new container with the given value in the Left state. See
 // also: Right.
 func Left[L any, R any](value L) Either[L, R] {
-	return left[L, R]{value}
+	var zero R
+
+	return Either[L, R]{left: value, right: zero, isRight: false}
 }
 
 // Right returns a new container with the given value in the Right state. See
 // also: Left.
 func Right[L any, R any](value R) Either[L, R] {
-	return right[L, R]{value}
+	var zero L
+
+	return Either[L, R]{left: zero, right: value, isRight: true}
 }
 
 // Match pattern-matches on the given `either` and returns the result of the
@@ -87,10 +37,10 @@ func Right[L any, R any](value R) Either[L, R] {
 // evaluated and given the underlying value.
 func Match[L any, R any, Out any](either Either[L, R], whenLeft func(L) Out, whenRight func(R) Out) Out {
 	if either.IsLeft() {
-		return whenLeft(either.(left[L, R]).value)
+		return whenLeft(either.left)
 	}
 
-	return whenRight(either.(right[L, R]).value)
+	return whenRight(either.right)
 }
 
 // Map maps `mapper` over the contained value of `either` and return the result
@@ -98,10 +48,10 @@ func Match[L any, R any, Out any](either Either[L, R], whenLeft func(L) Out, whe
 // MapRight.
 func Map[T any, Out any](either Either[T, T], mapper func(T) Out) Either[Out, Out] {
 	if either.IsLeft() {
-		return Left[Out, Out](mapper(either.(left[T, T]).value))
+		return Left[Out, Out](mapper(either.left))
 	}
 
-	return Right[Out, Out](mapper(either.(right[T, T]).value))
+	return Right[Out, Out](mapper(either.right))
 }
 
 // MapLeft applies the function `mapper` on the value in the Left variant, if it
@@ -109,10 +59,10 @@ func Map[T any, Out any](either Either[T, T], mapper func(T) Out) Either[Out, Ou
 // MapRight.
 func MapLeft[L any, R any, Out any](either Either[L, R], mapper func(L) Out) Either[Out, R] {
 	if either.IsLeft() {
-		return Left[Out, R](mapper(either.(left[L, R]).value))
+		return Left[Out, R](mapper(either.left))
 	}
 
-	return Right[Out, R](either.(right[L, R]).value)
+	return Right[Out, R](either.right)
 }
 
 // MapRight applies the function `mapper` on the value in the Right variant, if
@@ -120,8 +70,131 @@ func MapLeft[L any, R any, Out any](either Either[L, R], mapper func(L) Out) Eit
 // MapLeft.
 func MapRight[L any, R any, Out any](either Either[L, R], mapper func(R) Out) Either[L, Out] {
 	if either.IsLeft() {
-		return Left[L, Out](either.(left[L, R]).value)
+		return Left[L, Out](either.left)
+	}
+
+	return Right[L, Out](mapper(either.right))
+}
+
+// Equals checks if the container is equal to another container of the same
+// type.
+func (e Either[L, R]) Equals(other Either[L, R]) bool {
+	return reflect.DeepEqual(e, other)
+}
+
+// String returns a string representation of the container.
+func (e Either[L, R]) String() string {
+	if e.isRight {
+		return "Right[" + reflect.TypeOf(e.right).String() + "](" + fmt.Sprintf("%+v", e.right) + ")"
+	}
+
+	return "Left[" + reflect.TypeOf(e.left).String() + "](" + fmt.Sprintf("%+v", e.left) + ")"
+}
+
+// IsLeft returns true if the container is in the Left state. See also:
+// IsRight.
+func (e Either[L, R]) IsLeft() bool {
+	return !e.isRight
+}
+
+// IsRight returns true if the container is in the Right state. See also:
+// IsLeft.
+func (e Either[L, R]) IsRight() bool {
+	return e.isRight
+}
+
+// Left returns a maybe.Maybe representation of the container. If it is in the
+// Left state, the value is returned wrapped in a maybe.Some. If it is in the
+// Right state, a maybe.None is returned. See also: LeftOr, LeftOrElse, Right,
+// RightOr, RightOrElse.
+func (e Either[L, R]) Left() maybe.Maybe[L] {
+	if e.isRight {
+		return maybe.None[L]()
+	}
+
+	return maybe.Some(e.left)
+}
+
+// LeftOr returns the value if the container is in the Left state, or the given
+// default value. See also: Left, LeftOrElse, Right, RightOr, RightOrElse.
+func (e Either[L, R]) LeftOr(or L) L {
+	if e.isRight {
+		return or
+	}
+
+	return e.left
+}
+
+// LeftOrElse returns the value if the container is in the Left state, or the
+// result of calling the given function. See also: Left, LeftOr, Right,
+// RightOr, RightOrElse.
+func (e Either[L, R]) LeftOrElse(orElse func() L) L {
+	if e.isRight {
+		return orElse()
+	}
+
+	return e.left
+}
+
+// Right returns a maybe.Maybe representation of the container. If it is in the
+// Right state, the value is returned wrapped in a maybe.Some. If it is in the
+// Left state, a maybe.None is returned. See also: Left, LeftOr, LeftOrElse,
+// RightOr, RightOrElse.
+func (e Either[L, R]) Right() maybe.Maybe[R] {
+	if !e.isRight {
+		return maybe.None[R]()
+	}
+
+	return maybe.Some(e.right)
+}
+
+// RightOr returns the value if the container is in the Right state, or the
+// given default value. See also: Left, LeftOr, LeftOrElse, Right, RightOrElse.
+func (e Either[L, R]) RightOr(or R) R {
+	if !e.isRight {
+		return or
+	}
+
+	return e.right
+}
+
+// RightOrElse returns the value if the container is in the Right state, or the
+// result of calling the given function. See also: Left, LeftOr, LeftOrElse,
+// Right, RightOr.
+func (e Either[L, R]) RightOrElse(orElse func() R) R {
+	if !e.isRight {
+		return orElse()
+	}
+
+	return e.right
+}
+
+// UnwrapLeft returns the value if the container is in the Left state, or
+// panics. See also: UnwrapRight.
+func (e Either[L, R]) UnwrapLeft() L {
+	if e.isRight {
+		panic("nothing to unwrap left from Right")
+	}
+
+	return e.left
+}
+
+// UnwrapRight returns the value if the container is in the Right state, or
+// panics. See also: UnwrapLeft.
+func (e Either[L, R]) UnwrapRight() R {
+	if !e.isRight {
+		panic("nothing to unwrap right from Left")
+	}
+
+	return e.right
+}
+
+// Flip returns a new container with the state of the current container
+// reversed.
+func (e Either[L, R]) Flip() Either[R, L] {
+	if e.isRight {
+		return Left[R, L](e.right)
 	}
 
-	return Right[L, Out](mapper(either.(right[L, R]).value))
+	return Right[R, L](e.left)
 }
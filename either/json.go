@@ -0,0 +1,65 @@
+package either
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidJSON is returned when decoding JSON not shaped as an Either.
+var ErrInvalidJSON = errors.New(`either: expected either {"left": value} or {"right": value}`)
+
+// encoded is the tagged object an Either is encoded as. Only the field matching
+// the state of the Either is present.
+type encoded struct {
+	Left  json.RawMessage `json:"left,omitempty"`
+	Right json.RawMessage `json:"right,omitempty"`
+}
+
+// MarshalJSON encodes Left as {"left": value} and Right as {"right": value}.
+func (e Either[L, R]) MarshalJSON() ([]byte, error) {
+	if e.isRight {
+		value, err := json.Marshal(e.right)
+		if err != nil {
+			return nil, err //nolint:wrapcheck // surfacing the error as if encoding the value itself
+		}
+
+		return json.Marshal(encoded{Left: nil, Right: value})
+	}
+
+	value, err := json.Marshal(e.left)
+	if err != nil {
+		return nil, err //nolint:wrapcheck // surfacing the error as if encoding the value itself
+	}
+
+	return json.Marshal(encoded{Left: value, Right: nil})
+}
+
+// UnmarshalJSON decodes {"left": value} as Left and {"right": value} as Right.
+func (e *Either[L, R]) UnmarshalJSON(data []byte) error {
+	var tagged encoded
+	if err := json.Unmarshal(data, &tagged); err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidJSON, err.Error())
+	}
+
+	switch {
+	case tagged.Left != nil && tagged.Right == nil:
+		var value L
+		if err := json.Unmarshal(tagged.Left, &value); err != nil {
+			return err //nolint:wrapcheck // surfacing the error as if decoding the value itself
+		}
+
+		*e = Left[L, R](value)
+	case tagged.Left == nil && tagged.Right != nil:
+		var value R
+		if err := json.Unmarshal(tagged.Right, &value); err != nil {
+			return err //nolint:wrapcheck // surfacing the error as if decoding the value itself
+		}
+
+		*e = Right[L, R](value)
+	default:
+		return ErrInvalidJSON
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package either_test
+
+import (
+	stdjson "encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/gtramontina/go-extlib/either"
+	"github.com/gtramontina/go-extlib/json"
+	"github.com/gtramontina/go-extlib/testing/assert"
+)
+
+func TestEitherJSON(t *testing.T) {
+	encode := func(value any) string {
+		encoded, err := stdjson.Marshal(value)
+		assert.NoError(t, err)
+
+		return string(encoded)
+	}
+
+	t.Run("encodes Left and Right as tagged objects", func(t *testing.T) {
+		assert.Eq(t, encode(either.Left[int, string](1)), `{"left":1}`)
+		assert.Eq(t, encode(either.Right[int, string]("value")), `{"right":"value"}`)
+		assert.Eq(t, encode(either.Right[int, *int](nil)), `{"right":null}`)
+	})
+
+	t.Run("decodes tagged objects", func(t *testing.T) {
+		assert.Equals(t, json.Unmarshal[either.Either[int, string]](`{"left":1}`), either.Left[int, string](1))
+		assert.Equals(t, json.Unmarshal[either.Either[int, string]](`{"right":"value"}`), either.Right[int, string]("value"))
+	})
+
+	t.Run("round-trips", func(t *testing.T) {
+		type payload struct{ Values []either.Either[int, string] }
+
+		original := payload{[]either.Either[int, string]{either.Left[int, string](1), either.Right[int, string]("value")}}
+		assert.DeepEqual(t, json.Unmarshal[payload](encode(original)), original)
+	})
+
+	t.Run("fails decoding objects other than tagged ones", func(t *testing.T) {
+		for _, invalid := range []string{`{}`, `{"left":1,"right":"value"}`, `[]`, `1`} {
+			_, err := json.TryUnmarshal[either.Either[int, string]](invalid)
+			assert.True(t, errors.Is(err, either.ErrInvalidJSON), invalid)
+		}
+
+		_, err := json.TryUnmarshal[either.Either[int, string]](`{"left":"value"}`)
+		assert.Error(t, err)
+	})
+}
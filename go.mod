@@ -1,6 +1,6 @@
 module github.com/gtramontina/go-extlib
 
-go 1.18
+go 1.23
 
 require (
 	github.com/gtramontina/ooze v0.2.0
@@ -0,0 +1,65 @@
+package hashmap
+
+import (
+	"github.com/gtramontina/go-extlib/internal/table"
+	"github.com/gtramontina/go-extlib/maybe"
+)
+
+// Builder builds a HashMap by putting and removing entries in place, sparing
+// the copies each HashMap.Put and HashMap.Remove would otherwise make. Much
+// like strings.Builder, it is meant for building up a HashMap in bulk before
+// handing it over. The zero value is ready to use.
+type Builder[Key any, Value any] struct {
+	entries table.Builder[Key, Value]
+}
+
+// NewBuilder creates a Builder starting off with the entries of the given
+// HashMap, which is left untouched.
+func NewBuilder[Key any, Value any](from HashMap[Key, Value]) *Builder[Key, Value] {
+	return &Builder[Key, Value]{entries: *table.NewBuilder(from.entries)}
+}
+
+// Put puts the given key/value pair into the HashMap being built. If an entry
+// for the given Key already exists, its value is replaced with the given Value.
+func (b *Builder[Key, Value]) Put(key Key, value Value) *Builder[Key, Value] {
+	b.entries.Put(key, value)
+
+	return b
+}
+
+// Remove removes the entry whose key matches the given Key from the HashMap
+// being built.
+func (b *Builder[Key, Value]) Remove(key Key) *Builder[Key, Value] {
+	b.entries.Remove(key)
+
+	return b
+}
+
+// MaybeGet retrieves the Value for the given Key from the HashMap being built.
+func (b *Builder[Key, Value]) MaybeGet(key Key) maybe.Maybe[Value] {
+	value, ok := b.entries.Get(key)
+	if !ok {
+		return maybe.None[Value]()
+	}
+
+	return maybe.Some(value)
+}
+
+// HasKey returns true if the HashMap being built contains a Value for the given
+// Key; false otherwise.
+func (b *Builder[Key, Value]) HasKey(key Key) bool {
+	_, has := b.entries.Get(key)
+
+	return has
+}
+
+// Size returns the number of entries of the HashMap being built.
+func (b *Builder[Key, Value]) Size() int {
+	return b.entries.Size()
+}
+
+// Freeze returns the HashMap built so far, without copying it. The Builder can
+// still be used afterwards without affecting the returned HashMap.
+func (b *Builder[Key, Value]) Freeze() HashMap[Key, Value] {
+	return HashMap[Key, Value]{entries: b.entries.Freeze()}
+}
@@ -10,3 +10,13 @@ type Entry[Key any, Value any] struct {
 func Pair[Key any, Value any](key Key, value Value) Entry[Key, Value] {
 	return Entry[Key, Value]{key, value}
 }
+
+// Key returns the key this Entry holds.
+func (e Entry[Key, Value]) Key() Key {
+	return e.key
+}
+
+// Value returns the value this Entry holds.
+func (e Entry[Key, Value]) Value() Value {
+	return e.value
+}
@@ -1,32 +1,56 @@
 package hashmap
 
 import (
-	"reflect"
+	"iter"
 
 	"github.com/gtramontina/go-extlib/internal/hash"
+	"github.com/gtramontina/go-extlib/internal/table"
+	"github.com/gtramontina/go-extlib/iterator"
 	"github.com/gtramontina/go-extlib/maybe"
 	"github.com/gtramontina/go-extlib/set"
 )
 
-// HashMap implements a structure that maps keys to values. It uses a hash
-// function (hash.Calc) to compute a hash code used to identify values.
+// HashMap implements a structure that maps keys to values. Keys are identified
+// by a hash code computed from their type and contents, and keys whose hash
+// codes collide are told apart by comparing them field by field. Pointers,
+// channels and functions are compared by address, although HashMaps created
+// With FollowPointers compare pointers by the values they point to. Key types
+// may define their own identity by implementing both set.Hashable and
+// set.Equaler.
 type HashMap[Key any, Value any] struct {
-	entries map[uint64]Entry[Key, Value]
+	entries table.Table[Key, Value]
 }
 
 // New creates a new HashMap containing the given key/value entry pairs.
 func New[Key any, Value any](entries ...Entry[Key, Value]) HashMap[Key, Value] {
-	newEntries := make(map[uint64]Entry[Key, Value], len(entries))
+	var builder Builder[Key, Value]
 	for _, entry := range entries {
-		newEntries[hash.Calc(entry.key)] = entry
+		builder.Put(entry.key, entry.value)
 	}
 
-	return HashMap[Key, Value]{entries: newEntries}
+	return builder.Freeze()
+}
+
+// FromIterator creates a new HashMap containing all remaining key/value entry
+// pairs of the given iterator.Iterator, consuming it, and telling keys apart as
+// the given options describe. When two entries hold equal keys, the last one
+// wins.
+func FromIterator[Key any, Value any](
+	entries iterator.Iterator[Entry[Key, Value]],
+	options ...Option,
+) HashMap[Key, Value] {
+	builder := NewBuilder(With[Key, Value](options...))
+	for entries.HasNext() {
+		entry := entries.Next()
+		builder.Put(entry.key, entry.value)
+	}
+
+	return builder.Freeze()
 }
 
 // Size returns the number of key/value pair entries this HashMap holds.
 func (m HashMap[Key, Value]) Size() int {
-	return len(m.entries)
+	return m.entries.Size()
 }
 
 // Empty returns true when this HashMap does not hold any entries; false
@@ -36,99 +60,198 @@ func (m HashMap[Key, Value]) Empty() bool {
 }
 
 // Put creates a new HashMap containing all existing entries plus the newly
-// given key/value pair.
-// Note: if the calculated hash for the given Key collides with an existing
-// entry, the value will be replaced with the given Value.
+// given key/value pair. If an entry for the given Key already exists, its value
+// is replaced with the given Value.
 func (m HashMap[Key, Value]) Put(key Key, value Value) HashMap[Key, Value] {
-	newEntries := make(map[uint64]Entry[Key, Value], len(m.entries)+1)
-	for h, entry := range m.entries {
-		newEntries[h] = entry
-	}
-
-	newEntries[hash.Calc(key)] = Pair[Key, Value](key, value)
-
-	return HashMap[Key, Value]{entries: newEntries}
+	return HashMap[Key, Value]{entries: m.entries.Put(key, value)}
 }
 
 // Remove creates a new HashMap containing all existing entries but the one
-// whose hash code matches the given Key's. If none is found, the resulting
-// HashMap is equal to the original.
+// whose key matches the given Key. If none is found, the resulting HashMap is
+// equal to the original.
 func (m HashMap[Key, Value]) Remove(key Key) HashMap[Key, Value] {
-	newEntries := make(map[uint64]Entry[Key, Value], len(m.entries)+1)
-	hashedKey := hash.Calc(key)
-
-	for h, entry := range m.entries {
-		if h != hashedKey {
-			newEntries[h] = entry
-		}
-	}
-
-	return HashMap[Key, Value]{entries: newEntries}
+	return HashMap[Key, Value]{entries: m.entries.Remove(key)}
 }
 
 // MustGet retrieves the Value for the given Key. Panics when key is not found.
 // TODO: implement Maybe[Value].
 func (m HashMap[Key, Value]) MustGet(key Key) Value {
-	entry, ok := m.entries[hash.Calc(key)]
+	value, ok := m.entries.Get(key)
 	if !ok {
 		panic("hashmap: key not found")
 	}
 
-	return entry.value
+	return value
 }
 
 // MaybeGet retrieves the Value for the given Key. Returns a Maybe[Value] type.
 // Please refer to maybe.Maybe documentation for more information.
 func (m HashMap[Key, Value]) MaybeGet(key Key) maybe.Maybe[Value] {
-	entry, ok := m.entries[hash.Calc(key)]
+	value, ok := m.entries.Get(key)
 	if !ok {
 		return maybe.None[Value]()
 	}
 
-	return maybe.Some(entry.value)
+	return maybe.Some(value)
 }
 
-// Keys returns a set.Set of all keys contained in this HashMap.
-func (m HashMap[Key, Value]) Keys() set.Set[Key] {
-	keys := set.New[Key]()
-	for _, entry := range m.entries {
-		keys = keys.Add(entry.key)
+// GetOrDefault retrieves the Value for the given Key, or the given default
+// Value when the key is not found.
+func (m HashMap[Key, Value]) GetOrDefault(key Key, defaultValue Value) Value {
+	value, ok := m.entries.Get(key)
+	if !ok {
+		return defaultValue
 	}
 
-	return keys
+	return value
 }
 
-// Values returns a set.Set of all values contained in this HashMap.
-func (m HashMap[Key, Value]) Values() set.Set[Value] {
-	values := set.New[Value]()
-	for _, entry := range m.entries {
-		values = values.Add(entry.value)
+// Update creates a new HashMap where the entry for the given Key is replaced by
+// the result of the given function. The function receives the current Value as
+// a Maybe[Value], None when the key is not found; returning Some puts the
+// wrapped Value, while returning None removes the entry.
+func (m HashMap[Key, Value]) Update(key Key, updater func(maybe.Maybe[Value]) maybe.Maybe[Value]) HashMap[Key, Value] {
+	updated := updater(m.MaybeGet(key))
+	if updated.IsNone() {
+		return m.Remove(key)
+	}
+
+	return m.Put(key, updated.Unwrap())
+}
+
+// FilterEntries calls the provided predicate function once for each entry of
+// this HashMap, and constructs a new HashMap of all the entries for which the
+// predicate returns true.
+func (m HashMap[Key, Value]) FilterEntries(predicate func(Key, Value) bool) HashMap[Key, Value] {
+	builder := NewBuilder(With[Key, Value](m.entries.Options()...))
+
+	for _, entry := range m.entries.Entries() {
+		if predicate(entry.Key, entry.Value) {
+			builder.Put(entry.Key, entry.Value)
+		}
 	}
 
-	return values
+	return builder.Freeze()
+}
+
+// MergeWith creates a new HashMap containing the entries of both this and the
+// other HashMap. When both hold a value for the same key, the given resolve
+// function decides which value the key is mapped to, given the key, the value
+// from this HashMap and the value from the other HashMap, in this order.
+func (m HashMap[Key, Value]) MergeWith(
+	other HashMap[Key, Value],
+	resolve func(key Key, mine Value, theirs Value) Value,
+) HashMap[Key, Value] {
+	builder := NewBuilder(m)
+
+	for _, entry := range other.entries.Entries() {
+		if mine, ok := m.entries.Get(entry.Key); ok {
+			builder.Put(entry.Key, resolve(entry.Key, mine, entry.Value))
+		} else {
+			builder.Put(entry.Key, entry.Value)
+		}
+	}
+
+	return builder.Freeze()
+}
+
+// Keys returns a set.Set of all keys contained in this HashMap.
+func (m HashMap[Key, Value]) Keys() set.Set[Key] {
+	return set.FromIterator(iterator.Map(m.Iterator(), Entry[Key, Value].Key), m.entries.Options()...)
+}
+
+// Values returns a set.Set of all values contained in this HashMap.
+func (m HashMap[Key, Value]) Values() set.Set[Value] {
+	return set.FromIterator(iterator.Map(m.Iterator(), Entry[Key, Value].Value), m.entries.Options()...)
 }
 
 // Entries returns a set.Set of all key/value pair entries contained in this
 // HashMap.
 func (m HashMap[Key, Value]) Entries() set.Set[Entry[Key, Value]] {
-	entries := set.New[Entry[Key, Value]]()
-	for _, entry := range m.entries {
-		entries = entries.Add(entry)
-	}
+	return set.FromIterator(m.Iterator(), m.entries.Options()...)
+}
 
-	return entries
+// Iterator returns an iterator.Iterator over all key/value pair entries
+// contained in this HashMap in no particular order. It walks through the
+// entries lazily, as they are asked for.
+func (m HashMap[Key, Value]) Iterator() iterator.Iterator[Entry[Key, Value]] {
+	return iterator.Map(m.entries.All(), func(entry table.Entry[Key, Value]) Entry[Key, Value] {
+		return Pair(entry.Key, entry.Value)
+	})
+}
+
+// All returns an iter.Seq2 over all keys and values contained in this HashMap,
+// in no particular order, for use with range-over-func loops and the standard
+// library.
+func (m HashMap[Key, Value]) All() iter.Seq2[Key, Value] {
+	return func(yield func(Key, Value) bool) {
+		for entries := m.entries.All(); entries.HasNext(); {
+			if entry := entries.Next(); !yield(entry.Key, entry.Value) {
+				return
+			}
+		}
+	}
 }
 
 // HasKey returns true if this HashMap contains a Value for the given Key; false
 // otherwise.
 func (m HashMap[Key, Value]) HasKey(key Key) bool {
-	_, has := m.entries[hash.Calc(key)]
+	_, has := m.entries.Get(key)
 
 	return has
 }
 
 // Equals compares this HashMap with another HashMap. Returns true when all keys
-// and values are the same; false otherwise.
+// and values are the same; false otherwise. Values are compared the same way
+// keys are, so equal HashMaps always share the same hash code.
 func (m HashMap[Key, Value]) Equals(other HashMap[Key, Value]) bool {
-	return reflect.DeepEqual(m, other)
+	if m.Size() != other.Size() {
+		return false
+	}
+
+	for _, entry := range m.entries.Entries() {
+		otherValue, ok := other.entries.Get(entry.Key)
+		if !ok || !hash.Equal(entry.Value, otherValue, m.entries.Options()...) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Hash returns a hash code for this HashMap. Equal HashMaps share the same hash
+// code, regardless of the order their entries were put in, which allows
+// HashMaps to be keys of other HashMaps or members of set.Set.
+func (m HashMap[Key, Value]) Hash() uint64 {
+	var calculatedHash uint64
+	for _, entry := range m.entries.Entries() {
+		calculatedHash += hash.Calc(Pair(entry.Key, entry.Value), m.entries.Options()...)
+	}
+
+	return calculatedHash
+}
+
+// MapValues calls the provided mapper function once for each value of the
+// given HashMap, and constructs a new HashMap mapping the same keys to the
+// results.
+func MapValues[Key any, From any, To any](m HashMap[Key, From], mapper func(From) To) HashMap[Key, To] {
+	builder := NewBuilder(With[Key, To](m.entries.Options()...))
+	for _, entry := range m.entries.Entries() {
+		builder.Put(entry.Key, mapper(entry.Value))
+	}
+
+	return builder.Freeze()
+}
+
+// MapKeys calls the provided mapper function once for each key of the given
+// HashMap, and constructs a new HashMap mapping the results to the same values.
+// When the mapper maps different keys to equal keys, only one of their values
+// is kept, with no guarantee as to which.
+func MapKeys[From any, To any, Value any](m HashMap[From, Value], mapper func(From) To) HashMap[To, Value] {
+	builder := NewBuilder(With[To, Value](m.entries.Options()...))
+	for _, entry := range m.entries.Entries() {
+		builder.Put(mapper(entry.Key), entry.Value)
+	}
+
+	return builder.Freeze()
 }
@@ -1,14 +1,33 @@
 package hashmap_test
 
 import (
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/gtramontina/go-extlib/hashmap"
+	"github.com/gtramontina/go-extlib/iterator"
 	"github.com/gtramontina/go-extlib/maybe"
 	"github.com/gtramontina/go-extlib/set"
 	"github.com/gtramontina/go-extlib/testing/assert"
 )
 
+// collidingKey hashes all of its values to the same hash code.
+type collidingKey string
+
+func (collidingKey) Hash() uint64 { return 0 }
+
+type account struct {
+	id    int
+	cache string
+}
+
+func (a account) Hash() uint64              { return uint64(a.id) }
+func (a account) Equals(other account) bool { return a.id == other.id }
+
 func TestHashMap(t *testing.T) {
 	t.Run("has size", func(t *testing.T) {
 		assert.Eq(t, hashmap.New[string, int]().Size(), 0)
@@ -27,6 +46,9 @@ func TestHashMap(t *testing.T) {
 		assert.NotEquals(t, hashmap.New[string, int](), hashmap.New[string, int](hashmap.Pair("key1", 1)))
 		assert.Equals(t, hashmap.New[string, int](hashmap.Pair("key1", 1)), hashmap.New[string, int](hashmap.Pair("key1", 1)))
 		assert.Equals(t, hashmap.New[string, int](hashmap.Pair("key1", 1), hashmap.Pair("key2", 2)), hashmap.New[string, int](hashmap.Pair("key2", 2), hashmap.Pair("key1", 1)))
+		assert.Equals(t, hashmap.New(hashmap.Pair("key1", uintptr(1))), hashmap.New(hashmap.Pair("key1", uintptr(1))))
+		assert.NotEquals(t, hashmap.New(hashmap.Pair("key1", uintptr(1))), hashmap.New(hashmap.Pair("key1", uintptr(2))))
+		assert.Equals(t, hashmap.New(hashmap.Pair("key1", account{1, "cached"})), hashmap.New(hashmap.Pair("key1", account{1, ""})))
 	})
 
 	t.Run("tells whether it has a value for the given key", func(t *testing.T) {
@@ -70,6 +92,58 @@ func TestHashMap(t *testing.T) {
 		assert.DeepEqual(t, hashmap.New[string, int]().MaybeGet("unknown"), maybe.None[int]())
 	})
 
+	t.Run("can retrieve values or defaults based on keys", func(t *testing.T) {
+		filledMap := hashmap.New(hashmap.Pair("key1", 1))
+		assert.Eq(t, filledMap.GetOrDefault("key1", 0), 1)
+		assert.Eq(t, filledMap.GetOrDefault("nope", -1), -1)
+		assert.Eq(t, hashmap.New[string, int]().GetOrDefault("nope", 0), 0)
+	})
+
+	t.Run("allows updating entries based on their current values", func(t *testing.T) {
+		increment := func(current maybe.Maybe[int]) maybe.Maybe[int] { return maybe.Some(current.UnwrapOr(0) + 1) }
+		drop := func(maybe.Maybe[int]) maybe.Maybe[int] { return maybe.None[int]() }
+		filledMap := hashmap.New(hashmap.Pair("key1", 1), hashmap.Pair("key2", 2))
+
+		assert.Equals(t, filledMap.Update("key1", increment), hashmap.New(hashmap.Pair("key1", 2), hashmap.Pair("key2", 2)))
+		assert.Equals(t, filledMap.Update("key3", increment), filledMap.Put("key3", 1))
+		assert.Equals(t, filledMap.Update("key1", drop), hashmap.New(hashmap.Pair("key2", 2)))
+		assert.Equals(t, filledMap.Update("nope", drop), filledMap)
+		assert.Equals(t, filledMap, hashmap.New(hashmap.Pair("key1", 1), hashmap.Pair("key2", 2)))
+	})
+
+	t.Run("allows filtering entries", func(t *testing.T) {
+		filledMap := hashmap.New(hashmap.Pair("a", 1), hashmap.Pair("b", 2), hashmap.Pair("c", 3))
+		assert.Equals(t, filledMap.FilterEntries(func(string, int) bool { return true }), filledMap)
+		assert.Equals(t, filledMap.FilterEntries(func(string, int) bool { return false }), hashmap.New[string, int]())
+		assert.Equals(t, filledMap.FilterEntries(func(_ string, v int) bool { return v%2 == 1 }),
+			hashmap.New(hashmap.Pair("a", 1), hashmap.Pair("c", 3)))
+		assert.Equals(t, filledMap.FilterEntries(func(k string, _ int) bool { return k == "b" }),
+			hashmap.New(hashmap.Pair("b", 2)))
+	})
+
+	t.Run("allows merging with other hash maps", func(t *testing.T) {
+		sum := func(_ string, mine int, theirs int) int { return mine + theirs }
+		mine := hashmap.New(hashmap.Pair("a", 1), hashmap.Pair("b", 2))
+		theirs := hashmap.New(hashmap.Pair("b", 20), hashmap.Pair("c", 30))
+
+		assert.Equals(t, mine.MergeWith(theirs, sum), hashmap.New(hashmap.Pair("a", 1), hashmap.Pair("b", 22), hashmap.Pair("c", 30)))
+		assert.Equals(t, mine.MergeWith(hashmap.New[string, int](), sum), mine)
+		assert.Equals(t, hashmap.New[string, int]().MergeWith(theirs, sum), theirs)
+		assert.Equals(t, mine.MergeWith(theirs, func(_ string, mine int, _ int) int { return mine }),
+			hashmap.New(hashmap.Pair("a", 1), hashmap.Pair("b", 2), hashmap.Pair("c", 30)))
+		assert.Equals(t, mine, hashmap.New(hashmap.Pair("a", 1), hashmap.Pair("b", 2)))
+	})
+
+	t.Run("allows mapping values and keys", func(t *testing.T) {
+		filledMap := hashmap.New(hashmap.Pair("a", 1), hashmap.Pair("b", 2))
+		assert.Equals(t, hashmap.MapValues(filledMap, func(v int) string { return fmt.Sprint(v * 10) }),
+			hashmap.New(hashmap.Pair("a", "10"), hashmap.Pair("b", "20")))
+		assert.Equals(t, hashmap.MapKeys(filledMap, strings.ToUpper),
+			hashmap.New(hashmap.Pair("A", 1), hashmap.Pair("B", 2)))
+		assert.Eq(t, hashmap.MapKeys(filledMap, func(string) int { return 0 }).Size(), 1)
+		assert.Equals(t, hashmap.MapValues(hashmap.New[string, int](), func(v int) int { return v }), hashmap.New[string, int]())
+	})
+
 	t.Run("allows accessing all keys", func(t *testing.T) {
 		assert.DeepEqual(t, hashmap.New[string, int]().Keys(), set.New[string]())
 		assert.DeepEqual(t, hashmap.New[string, int](hashmap.Pair("key1", 1)).Keys(), set.New("key1"))
@@ -87,4 +161,179 @@ func TestHashMap(t *testing.T) {
 		assert.DeepEqual(t, hashmap.New[string, int](hashmap.Pair("key1", 1)).Entries(), set.New[hashmap.Entry[string, int]](hashmap.Pair("key1", 1)))
 		assert.DeepEqual(t, hashmap.New[string, int](hashmap.Pair("key1", 1), hashmap.Pair("key2", 2)).Entries(), set.New[hashmap.Entry[string, int]](hashmap.Pair("key1", 1), hashmap.Pair("key2", 2)))
 	})
+	t.Run("can be walked through lazily", func(t *testing.T) {
+		assert.False(t, hashmap.New[string, int]().Iterator().HasNext())
+
+		filled := hashmap.New(hashmap.Pair("key1", 1), hashmap.Pair("key2", 2))
+		assert.Equals(t, hashmap.FromIterator(filled.Iterator()), filled)
+
+		iter := hashmap.New(hashmap.Pair("key1", 1)).Iterator()
+		assert.True(t, iter.HasNext())
+		assert.Eq(t, iter.Next(), hashmap.Pair("key1", 1))
+		assert.False(t, iter.HasNext())
+	})
+
+	t.Run("can be ranged over", func(t *testing.T) {
+		filled := hashmap.New(hashmap.Pair("key1", 1), hashmap.Pair("key2", 2))
+		assert.DeepEqual(t, maps.Collect(filled.All()), map[string]int{"key1": 1, "key2": 2})
+		assert.DeepEqual(t, slices.Sorted(maps.Keys(maps.Collect(filled.All()))), []string{"key1", "key2"})
+		assert.Eq(t, len(maps.Collect(hashmap.New[string, int]().All())), 0)
+
+		for key := range filled.All() {
+			if key == "key1" {
+				break
+			}
+		}
+	})
+
+	t.Run("can be created from iterators", func(t *testing.T) {
+		assert.Equals(t, hashmap.FromIterator(iterator.From[hashmap.Entry[string, int]]()), hashmap.New[string, int]())
+		assert.Equals(t,
+			hashmap.FromIterator(iterator.From(hashmap.Pair("key1", 1), hashmap.Pair("key2", 2), hashmap.Pair("key1", 0))),
+			hashmap.New(hashmap.Pair("key1", 0), hashmap.Pair("key2", 2)),
+		)
+	})
+
+	t.Run("keeps keys with colliding hashes apart", func(t *testing.T) {
+		keyA, keyB := collidingKey("a"), collidingKey("b")
+		colliding := hashmap.New[collidingKey, string]().Put(keyA, "a").Put(keyB, "b")
+		assert.Eq(t, colliding.Size(), 2)
+		assert.Eq(t, colliding.MustGet(keyA), "a")
+		assert.Eq(t, colliding.MustGet(keyB), "b")
+		assert.False(t, colliding.HasKey(collidingKey("c")))
+		assert.Equals(t, colliding.Remove(keyA), hashmap.New(hashmap.Pair(keyB, "b")))
+		assert.NotEquals(t, hashmap.New(hashmap.Pair(keyA, "a")), hashmap.New(hashmap.Pair(keyB, "a")))
+	})
+
+	t.Run("honours the identity keys define for themselves", func(t *testing.T) {
+		balances := hashmap.New(hashmap.Pair(account{1, "cached"}, 10)).Put(account{1, ""}, 20)
+		assert.Eq(t, balances.Size(), 1)
+		assert.Eq(t, balances.MustGet(account{1, "stale"}), 20)
+		assert.False(t, balances.HasKey(account{2, "cached"}))
+	})
+
+	t.Run("may have nil pointers to keys defining their identity", func(t *testing.T) {
+		type holder struct{ account *account }
+
+		byAccount := hashmap.New(hashmap.Pair[*account](nil, 1), hashmap.Pair[*account](nil, 2))
+		assert.Eq(t, byAccount.Size(), 1)
+		assert.Eq(t, byAccount.MustGet(nil), 2)
+
+		byHolder := hashmap.New(hashmap.Pair(holder{nil}, 1), hashmap.Pair(holder{&account{1, ""}}, 2))
+		assert.Eq(t, byHolder.Size(), 2)
+		assert.Eq(t, byHolder.MustGet(holder{nil}), 1)
+	})
+
+	t.Run("may tell pointer keys apart by the values they point to", func(t *testing.T) {
+		keyA, keyB, keyC := "a", "a", "c"
+
+		assert.Eq(t, hashmap.New(hashmap.Pair(&keyA, 1), hashmap.Pair(&keyB, 2)).Size(), 2)
+
+		byPointee := hashmap.With[*string, int](hashmap.FollowPointers()).Put(&keyA, 1).Put(&keyB, 2).Put(&keyC, 3)
+		assert.Eq(t, byPointee.Size(), 2)
+		assert.Eq(t, byPointee.MustGet(&keyA), 2)
+		assert.Eq(t, byPointee.FilterEntries(func(*string, int) bool { return true }).Put(&keyA, 4).Size(), 2)
+		assert.True(t, byPointee.Keys().Contains(&keyB))
+		assert.Eq(t, hashmap.MapValues(byPointee, strconv.Itoa).MustGet(&keyB), "2")
+
+		other := hashmap.With[*string, int](hashmap.FollowPointers()).Put(&keyC, 3).Put(&keyB, 2)
+		assert.Equals(t, byPointee, other)
+		assert.Eq(t, byPointee.Hash(), other.Hash())
+	})
+
+	t.Run("may have hash maps as keys", func(t *testing.T) {
+		keyA := hashmap.New(hashmap.Pair("a", 1), hashmap.Pair("b", 2))
+		keyB := hashmap.New(hashmap.Pair("b", 2), hashmap.Pair("a", 1))
+		nested := hashmap.New(hashmap.Pair(keyA, "first")).Put(keyB, "second")
+		assert.Eq(t, nested.Size(), 1)
+		assert.Eq(t, nested.MustGet(hashmap.New[string, int]().Put("a", 1).Put("b", 2)), "second")
+		assert.Eq(t, keyA.Hash(), keyB.Hash())
+	})
+
+	t.Run("shares the same hash with equal hash maps", func(t *testing.T) {
+		valueA, valueB := 1, 1
+		mapA := hashmap.New(hashmap.Pair("a", &valueA))
+
+		assert.Equals(t, mapA, hashmap.New(hashmap.Pair("a", &valueA)))
+		assert.Eq(t, mapA.Hash(), hashmap.New(hashmap.Pair("a", &valueA)).Hash())
+		assert.NotEquals(t, mapA, hashmap.New(hashmap.Pair("a", &valueB)))
+		assert.Eq(t, set.New(mapA, hashmap.New(hashmap.Pair("a", &valueA))).Cardinality(), 1)
+		assert.Eq(t, set.New(mapA, hashmap.New(hashmap.Pair("a", &valueB))).Cardinality(), 2)
+	})
+
+	t.Run("can be built in place", func(t *testing.T) {
+		var builder hashmap.Builder[string, int]
+		assert.Equals(t, builder.Freeze(), hashmap.New[string, int]())
+
+		builder.Put("key1", 1).Put("key2", 2).Put("key3", 3).Remove("key2")
+		assert.Eq(t, builder.Size(), 2)
+		assert.True(t, builder.HasKey("key1"))
+		assert.False(t, builder.HasKey("key2"))
+		assert.DeepEqual(t, builder.MaybeGet("key3"), maybe.Some(3))
+		assert.DeepEqual(t, builder.MaybeGet("key2"), maybe.None[int]())
+
+		frozen := builder.Freeze()
+		builder.Put("key1", 0).Remove("key3")
+		assert.Equals(t, frozen, hashmap.New(hashmap.Pair("key1", 1), hashmap.Pair("key3", 3)))
+		assert.Equals(t, builder.Freeze(), hashmap.New(hashmap.Pair("key1", 0)))
+
+		t.Run("does not mutate the hash map it starts off with", func(t *testing.T) {
+			original := hashmap.New(hashmap.Pair("key1", 1))
+			extended := hashmap.NewBuilder(original).Put("key1", 0).Put("key2", 2).Freeze()
+			assert.Equals(t, original, hashmap.New(hashmap.Pair("key1", 1)))
+			assert.Equals(t, extended, hashmap.New(hashmap.Pair("key1", 0), hashmap.Pair("key2", 2)))
+		})
+	})
+}
+
+func BenchmarkHashMap(b *testing.B) {
+	const size = 100_000
+
+	full := hashmap.New[int, int]()
+	for i := 0; i < size; i++ {
+		full = full.Put(i, i)
+	}
+
+	b.Run("putting 100k entries one by one", func(b *testing.B) {
+		b.ReportAllocs()
+
+		for n := 0; n < b.N; n++ {
+			entries := hashmap.New[int, int]()
+			for i := 0; i < size; i++ {
+				entries = entries.Put(i, i)
+			}
+		}
+	})
+
+	b.Run("removing 100k entries one by one", func(b *testing.B) {
+		b.ReportAllocs()
+
+		for n := 0; n < b.N; n++ {
+			entries := full
+			for i := 0; i < size; i++ {
+				entries = entries.Remove(i)
+			}
+		}
+	})
+
+	b.Run("building 100k entries in place", func(b *testing.B) {
+		b.ReportAllocs()
+
+		for n := 0; n < b.N; n++ {
+			var builder hashmap.Builder[int, int]
+			for i := 0; i < size; i++ {
+				builder.Put(i, i)
+			}
+
+			_ = builder.Freeze()
+		}
+	})
+
+	b.Run("collecting the keys of 100k entries", func(b *testing.B) {
+		b.ReportAllocs()
+
+		for n := 0; n < b.N; n++ {
+			_ = full.Keys()
+		}
+	})
 }
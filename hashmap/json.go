@@ -0,0 +1,93 @@
+package hashmap
+
+import (
+	"errors"
+
+	"github.com/gtramontina/go-extlib/internal/jsonentries"
+	"github.com/gtramontina/go-extlib/internal/jsonorder"
+	"github.com/gtramontina/go-extlib/iterator"
+)
+
+// ErrInvalidJSON is returned when decoding JSON not shaped as a HashMap.
+var ErrInvalidJSON = errors.New("hashmap: expected an array of [key, value] pairs")
+
+// MarshalJSON encodes this HashMap as an object when its keys are strings, and
+// as an array of [key, value] pairs otherwise. Either way, entries are sorted by
+// key so that equal HashMaps are always encoded the same way. Numbers are
+// sorted numerically and strings alphabetically; any other keys are sorted by
+// their encoding.
+func (m HashMap[Key, Value]) MarshalJSON() ([]byte, error) {
+	entries, err := encodeEntries(m.Iterator())
+	if err != nil {
+		return nil, err
+	}
+
+	jsonorder.Sort(entries, func(entry jsonentries.Encoded) []byte { return entry.Key })
+
+	return jsonentries.Write[Key](entries), nil
+}
+
+// UnmarshalJSON decodes an object into a HashMap when its keys are strings, and
+// an array of [key, value] pairs otherwise. When two entries hold equal keys,
+// the last one wins. The decoded HashMap keeps the options this HashMap was
+// created with.
+func (m *HashMap[Key, Value]) UnmarshalJSON(data []byte) error {
+	builder := NewBuilder(With[Key, Value](m.entries.Options()...))
+
+	err := jsonentries.Decode(data, ErrInvalidJSON, func(key Key, value Value) { builder.Put(key, value) })
+	if err != nil {
+		return err //nolint:wrapcheck // surfacing the error as if decoding the entries here
+	}
+
+	*m = builder.Freeze()
+
+	return nil
+}
+
+// MarshalJSON encodes this LinkedHashMap as an object when its keys are
+// strings, and as an array of [key, value] pairs otherwise. Either way, entries
+// come in the order their keys were first put in.
+func (m LinkedHashMap[Key, Value]) MarshalJSON() ([]byte, error) {
+	entries, err := encodeEntries(m.Iterator())
+	if err != nil {
+		return nil, err
+	}
+
+	return jsonentries.Write[Key](entries), nil
+}
+
+// UnmarshalJSON decodes an object into a LinkedHashMap when its keys are
+// strings, and an array of [key, value] pairs otherwise, keeping the order the
+// entries come in. When two entries hold equal keys, the last value is put in
+// the position of the first one.
+func (m *LinkedHashMap[Key, Value]) UnmarshalJSON(data []byte) error {
+	entries := make([]Entry[Key, Value], 0)
+
+	err := jsonentries.Decode(data, ErrInvalidJSON, func(key Key, value Value) {
+		entries = append(entries, Pair(key, value))
+	})
+	if err != nil {
+		return err //nolint:wrapcheck // surfacing the error as if decoding the entries here
+	}
+
+	*m = NewLinked(entries...)
+
+	return nil
+}
+
+func encodeEntries[Key any, Value any](entries iterator.Iterator[Entry[Key, Value]]) ([]jsonentries.Encoded, error) {
+	encoded := make([]jsonentries.Encoded, 0)
+
+	for entries.HasNext() {
+		entry := entries.Next()
+
+		encodedEntry, err := jsonentries.Encode(entry.key, entry.value)
+		if err != nil {
+			return nil, err //nolint:wrapcheck // surfacing the error as if encoding the entry here
+		}
+
+		encoded = append(encoded, encodedEntry)
+	}
+
+	return encoded, nil
+}
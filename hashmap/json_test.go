@@ -0,0 +1,118 @@
+package hashmap_test
+
+import (
+	stdjson "encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/gtramontina/go-extlib/hashmap"
+	"github.com/gtramontina/go-extlib/json"
+	"github.com/gtramontina/go-extlib/testing/assert"
+)
+
+func TestHashMapJSON(t *testing.T) {
+	type name string
+
+	encode := func(value any) string {
+		encoded, err := stdjson.Marshal(value)
+		assert.NoError(t, err)
+
+		return string(encoded)
+	}
+
+	t.Run("encodes as an object sorted by key when keys are strings", func(t *testing.T) {
+		assert.Eq(t, encode(hashmap.New[string, int]()), `{}`)
+		assert.Eq(t, encode(hashmap.New(hashmap.Pair("b", 2), hashmap.Pair("a", 1))), `{"a":1,"b":2}`)
+		assert.Eq(t, encode(hashmap.New(hashmap.Pair[name](`"quoted"`, []int{1}))), `{"\"quoted\"":[1]}`)
+	})
+
+	t.Run("encodes as an array of pairs sorted by key otherwise", func(t *testing.T) {
+		assert.Eq(t, encode(hashmap.New[int, string]()), `[]`)
+		assert.Eq(t, encode(hashmap.New(hashmap.Pair(10, "b"), hashmap.Pair(9, "a"))), `[[9,"a"],[10,"b"]]`)
+		assert.Eq(t, encode(hashmap.New(hashmap.Pair(true, 1), hashmap.Pair(false, 0))), `[[false,0],[true,1]]`)
+	})
+
+	t.Run("decodes objects and arrays of pairs", func(t *testing.T) {
+		assert.Equals(t, json.Unmarshal[hashmap.HashMap[string, int]](`{"a":1,"b":2}`),
+			hashmap.New(hashmap.Pair("a", 1), hashmap.Pair("b", 2)))
+		assert.Equals(t, json.Unmarshal[hashmap.HashMap[name, int]](`{"a":1}`), hashmap.New(hashmap.Pair[name]("a", 1)))
+		assert.Equals(t, json.Unmarshal[hashmap.HashMap[int, string]](`[[1,"a"],[2,"b"],[1,"c"]]`),
+			hashmap.New(hashmap.Pair(1, "c"), hashmap.Pair(2, "b")))
+	})
+
+	t.Run("round-trips", func(t *testing.T) {
+		type payload struct {
+			Counts hashmap.HashMap[string, int]
+			Names  hashmap.HashMap[int, string]
+		}
+
+		original := payload{hashmap.New(hashmap.Pair("a", 1)), hashmap.New(hashmap.Pair(1, "a"))}
+		decoded := json.Unmarshal[payload](encode(original))
+		assert.Equals(t, decoded.Counts, original.Counts)
+		assert.Equals(t, decoded.Names, original.Names)
+	})
+
+	t.Run("fails decoding anything but arrays of pairs when keys are not strings", func(t *testing.T) {
+		for _, invalid := range []string{`{"1":"a"}`, `[[1]]`, `[[1,"a",2]]`, `[1]`} {
+			_, err := json.TryUnmarshal[hashmap.HashMap[int, string]](invalid)
+			assert.True(t, errors.Is(err, hashmap.ErrInvalidJSON), invalid)
+		}
+
+		_, err := json.TryUnmarshal[hashmap.HashMap[string, int]](`[]`)
+		assert.Error(t, err)
+	})
+}
+
+func TestLinkedHashMapJSON(t *testing.T) {
+	encode := func(value any) string {
+		encoded, err := stdjson.Marshal(value)
+		assert.NoError(t, err)
+
+		return string(encoded)
+	}
+
+	t.Run("encodes as an object in insertion order when keys are strings", func(t *testing.T) {
+		assert.Eq(t, encode(hashmap.NewLinked[string, int]()), `{}`)
+		assert.Eq(t, encode(hashmap.LinkedHashMap[string, int]{}), `{}`)
+		assert.Eq(t, encode(hashmap.NewLinked(hashmap.Pair("b", 2), hashmap.Pair("a", 1), hashmap.Pair("c", 3))),
+			`{"b":2,"a":1,"c":3}`)
+	})
+
+	t.Run("encodes as an array of pairs in insertion order otherwise", func(t *testing.T) {
+		assert.Eq(t, encode(hashmap.NewLinked[int, string]()), `[]`)
+		assert.Eq(t, encode(hashmap.NewLinked(hashmap.Pair(10, "b"), hashmap.Pair(9, "a"))), `[[10,"b"],[9,"a"]]`)
+	})
+
+	t.Run("decodes keeping the order entries come in", func(t *testing.T) {
+		decoded := json.Unmarshal[hashmap.LinkedHashMap[string, int]](`{"b":2,"a":1,"c":3,"b":4}`)
+		assert.DeepEqual(t, decoded.Keys(), []string{"b", "a", "c"})
+		assert.DeepEqual(t, decoded.Values(), []int{4, 1, 3})
+
+		pairs := json.Unmarshal[hashmap.LinkedHashMap[int, string]](`[[2,"b"],[1,"a"]]`)
+		assert.DeepEqual(t, pairs.Keys(), []int{2, 1})
+		assert.Eq(t, json.Unmarshal[hashmap.LinkedHashMap[string, int]](`null`).Size(), 0)
+	})
+
+	t.Run("round-trips", func(t *testing.T) {
+		type payload struct {
+			Counts hashmap.LinkedHashMap[string, int]
+			Names  hashmap.LinkedHashMap[int, string]
+		}
+
+		original := payload{
+			hashmap.NewLinked(hashmap.Pair("z", 1), hashmap.Pair("a", 2)),
+			hashmap.NewLinked(hashmap.Pair(2, "b"), hashmap.Pair(1, "a")),
+		}
+		encoded := encode(original)
+		assert.Eq(t, encoded, `{"Counts":{"z":1,"a":2},"Names":[[2,"b"],[1,"a"]]}`)
+		assert.Eq(t, encode(json.Unmarshal[payload](encoded)), encoded)
+	})
+
+	t.Run("fails decoding anything but arrays of pairs when keys are not strings", func(t *testing.T) {
+		_, err := json.TryUnmarshal[hashmap.LinkedHashMap[int, string]](`{"1":"a"}`)
+		assert.True(t, errors.Is(err, hashmap.ErrInvalidJSON))
+
+		_, err = json.TryUnmarshal[hashmap.LinkedHashMap[string, int]](`[]`)
+		assert.Error(t, err)
+	})
+}
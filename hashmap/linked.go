@@ -0,0 +1,207 @@
+package hashmap
+
+import (
+	"iter"
+
+	"github.com/gtramontina/go-extlib/internal/hash"
+	"github.com/gtramontina/go-extlib/internal/table"
+	"github.com/gtramontina/go-extlib/internal/tree"
+	"github.com/gtramontina/go-extlib/iterator"
+	"github.com/gtramontina/go-extlib/maybe"
+)
+
+// LinkedHashMap is a HashMap that remembers the order its keys were first put
+// in, going through its keys, values and entries in that order every time.
+// Putting a value for a key already present keeps its position, while removing
+// a key and putting it back moves it to the end. Apart from Keys, Values and
+// Entries returning slices rather than sets, it behaves just like HashMap,
+// including the order-insensitive Equals and Hash.
+type LinkedHashMap[Key any, Value any] struct {
+	entries table.Table[Key, linkedValue[Value]]
+	order   tree.Tree[uint64, Entry[Key, Value]]
+	next    uint64
+}
+
+// linkedValue holds a value along with the position its key was put in.
+type linkedValue[Value any] struct {
+	value    Value
+	position uint64
+}
+
+// NewLinked creates a new LinkedHashMap containing the given key/value entry
+// pairs, in the given order.
+func NewLinked[Key any, Value any](entries ...Entry[Key, Value]) LinkedHashMap[Key, Value] {
+	linked := LinkedHashMap[Key, Value]{
+		entries: table.New[Key, linkedValue[Value]](),
+		order:   tree.New[uint64, Entry[Key, Value]](tree.Ascending[uint64]),
+		next:    0,
+	}
+
+	for _, entry := range entries {
+		linked = linked.Put(entry.key, entry.value)
+	}
+
+	return linked
+}
+
+// Size returns the number of key/value pair entries this LinkedHashMap holds.
+func (m LinkedHashMap[Key, Value]) Size() int {
+	return m.entries.Size()
+}
+
+// Empty returns true when this LinkedHashMap does not hold any entries; false
+// otherwise.
+func (m LinkedHashMap[Key, Value]) Empty() bool {
+	return m.Size() == 0
+}
+
+// Put creates a new LinkedHashMap containing all existing entries plus the
+// newly given key/value pair. If an entry for the given Key already exists, its
+// value is replaced with the given Value and it keeps its position; otherwise,
+// the new entry comes last.
+func (m LinkedHashMap[Key, Value]) Put(key Key, value Value) LinkedHashMap[Key, Value] {
+	position, next := m.next, m.next+1
+	if existing, ok := m.entries.Get(key); ok {
+		position, next = existing.position, m.next
+	}
+
+	return LinkedHashMap[Key, Value]{
+		entries: m.entries.Put(key, linkedValue[Value]{value: value, position: position}),
+		order:   m.ordering().Put(position, Pair(key, value)),
+		next:    next,
+	}
+}
+
+// Remove creates a new LinkedHashMap containing all existing entries but the
+// one whose key matches the given Key. If none is found, the resulting
+// LinkedHashMap is equal to the original.
+func (m LinkedHashMap[Key, Value]) Remove(key Key) LinkedHashMap[Key, Value] {
+	existing, ok := m.entries.Get(key)
+	if !ok {
+		return m
+	}
+
+	return LinkedHashMap[Key, Value]{
+		entries: m.entries.Remove(key),
+		order:   m.ordering().Remove(existing.position),
+		next:    m.next,
+	}
+}
+
+// MustGet retrieves the Value for the given Key. Panics when key is not found.
+func (m LinkedHashMap[Key, Value]) MustGet(key Key) Value {
+	existing, ok := m.entries.Get(key)
+	if !ok {
+		panic("hashmap: key not found")
+	}
+
+	return existing.value
+}
+
+// MaybeGet retrieves the Value for the given Key. Returns a Maybe[Value] type.
+// Please refer to maybe.Maybe documentation for more information.
+func (m LinkedHashMap[Key, Value]) MaybeGet(key Key) maybe.Maybe[Value] {
+	existing, ok := m.entries.Get(key)
+	if !ok {
+		return maybe.None[Value]()
+	}
+
+	return maybe.Some(existing.value)
+}
+
+// HasKey returns true if this LinkedHashMap contains a Value for the given Key;
+// false otherwise.
+func (m LinkedHashMap[Key, Value]) HasKey(key Key) bool {
+	_, has := m.entries.Get(key)
+
+	return has
+}
+
+// Keys returns all keys contained in this LinkedHashMap, in the order they
+// were first put in.
+func (m LinkedHashMap[Key, Value]) Keys() []Key {
+	keys := make([]Key, 0, m.Size())
+	for _, entry := range m.Entries() {
+		keys = append(keys, entry.key)
+	}
+
+	return keys
+}
+
+// Values returns all values contained in this LinkedHashMap, in the order
+// their keys were first put in.
+func (m LinkedHashMap[Key, Value]) Values() []Value {
+	values := make([]Value, 0, m.Size())
+	for _, entry := range m.Entries() {
+		values = append(values, entry.value)
+	}
+
+	return values
+}
+
+// Entries returns all key/value pair entries contained in this LinkedHashMap,
+// in the order their keys were first put in.
+func (m LinkedHashMap[Key, Value]) Entries() []Entry[Key, Value] {
+	return m.Iterator().Collect()
+}
+
+// Iterator returns an iterator.Iterator over all key/value pair entries
+// contained in this LinkedHashMap, in the order their keys were first put in.
+func (m LinkedHashMap[Key, Value]) Iterator() iterator.Iterator[Entry[Key, Value]] {
+	return iterator.Map(m.ordering().All(), func(positioned tree.Entry[uint64, Entry[Key, Value]]) Entry[Key, Value] {
+		return positioned.Value
+	})
+}
+
+// All returns an iter.Seq2 over all keys and values contained in this
+// LinkedHashMap, in the order their keys were first put in, for use with
+// range-over-func loops and the standard library.
+func (m LinkedHashMap[Key, Value]) All() iter.Seq2[Key, Value] {
+	return func(yield func(Key, Value) bool) {
+		for entries := m.Iterator(); entries.HasNext(); {
+			entry := entries.Next()
+			if !yield(entry.key, entry.value) {
+				return
+			}
+		}
+	}
+}
+
+// Equals compares this LinkedHashMap with another LinkedHashMap. Returns true
+// when all keys and values are the same, regardless of their order; false
+// otherwise.
+func (m LinkedHashMap[Key, Value]) Equals(other LinkedHashMap[Key, Value]) bool {
+	if m.Size() != other.Size() {
+		return false
+	}
+
+	for _, entry := range m.entries.Entries() {
+		otherValue, ok := other.entries.Get(entry.Key)
+		if !ok || !hash.Equal(entry.Value.value, otherValue.value) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Hash returns a hash code for this LinkedHashMap. Equal LinkedHashMaps share
+// the same hash code, regardless of the order their entries were put in.
+func (m LinkedHashMap[Key, Value]) Hash() uint64 {
+	var calculatedHash uint64
+	for _, entry := range m.entries.Entries() {
+		calculatedHash += hash.Calc(Pair(entry.Key, entry.Value.value))
+	}
+
+	return calculatedHash
+}
+
+// ordering returns the tree holding the entries by position, which is only
+// missing from the zero value, before anything was ever put in.
+func (m LinkedHashMap[Key, Value]) ordering() tree.Tree[uint64, Entry[Key, Value]] {
+	if m.next == 0 {
+		return tree.New[uint64, Entry[Key, Value]](tree.Ascending[uint64])
+	}
+
+	return m.order
+}
@@ -0,0 +1,116 @@
+package hashmap_test
+
+import (
+	"maps"
+	"strconv"
+	"testing"
+
+	"github.com/gtramontina/go-extlib/hashmap"
+	"github.com/gtramontina/go-extlib/iterator"
+	"github.com/gtramontina/go-extlib/maybe"
+	"github.com/gtramontina/go-extlib/testing/assert"
+)
+
+func TestLinkedHashMap(t *testing.T) {
+	keys := func(m hashmap.LinkedHashMap[string, int]) []string {
+		return iterator.Map(m.Iterator(), hashmap.Entry[string, int].Key).Collect()
+	}
+
+	t.Run("behaves like a hash map", func(t *testing.T) {
+		linked := hashmap.NewLinked(hashmap.Pair("key1", 1), hashmap.Pair("key2", 2))
+		assert.Eq(t, linked.Size(), 2)
+		assert.False(t, linked.Empty())
+		assert.True(t, hashmap.NewLinked[string, int]().Empty())
+		assert.Eq(t, linked.MustGet("key1"), 1)
+		assert.DeepEqual(t, linked.MaybeGet("key2"), maybe.Some(2))
+		assert.DeepEqual(t, linked.MaybeGet("nope"), maybe.None[int]())
+		assert.True(t, linked.HasKey("key1"))
+		assert.False(t, linked.Remove("key1").HasKey("key1"))
+		assert.PanicsWith(t, func() { linked.MustGet("nope") }, "hashmap: key not found")
+	})
+
+	t.Run("iterates in the order keys were put in", func(t *testing.T) {
+		linked := hashmap.NewLinked[string, int]().Put("c", 0).Put("a", 1).Put("b", 2)
+		assert.DeepEqual(t, keys(linked), []string{"c", "a", "b"})
+		assert.DeepEqual(t, linked.Iterator().Collect(), []hashmap.Entry[string, int]{
+			hashmap.Pair("c", 0), hashmap.Pair("a", 1), hashmap.Pair("b", 2),
+		})
+		assert.DeepEqual(t, keys(hashmap.NewLinked(hashmap.Pair("b", 0), hashmap.Pair("a", 0))), []string{"b", "a"})
+	})
+
+	t.Run("lists keys, values and entries in the order keys were put in", func(t *testing.T) {
+		linked := hashmap.NewLinked[string, int]().Put("c", 0).Put("a", 1).Put("b", 2).Put("a", 3)
+		assert.DeepEqual(t, linked.Keys(), []string{"c", "a", "b"})
+		assert.DeepEqual(t, linked.Values(), []int{0, 3, 2})
+		assert.DeepEqual(t, linked.Entries(), []hashmap.Entry[string, int]{
+			hashmap.Pair("c", 0), hashmap.Pair("a", 3), hashmap.Pair("b", 2),
+		})
+		assert.DeepEqual(t, linked.Remove("c").Keys(), []string{"a", "b"})
+		assert.DeepEqual(t, hashmap.NewLinked[string, int]().Keys(), []string{})
+	})
+
+	t.Run("is ready to use as a zero value", func(t *testing.T) {
+		var linked hashmap.LinkedHashMap[string, int]
+		assert.True(t, linked.Empty())
+		assert.DeepEqual(t, linked.Entries(), []hashmap.Entry[string, int]{})
+		assert.DeepEqual(t, linked.Remove("a").Keys(), []string{})
+		assert.DeepEqual(t, linked.Put("b", 1).Put("a", 2).Keys(), []string{"b", "a"})
+	})
+
+	t.Run("keeps the position of keys put again", func(t *testing.T) {
+		linked := hashmap.NewLinked(hashmap.Pair("a", 0), hashmap.Pair("b", 0), hashmap.Pair("a", 1)).Put("b", 1)
+		assert.DeepEqual(t, keys(linked), []string{"a", "b"})
+		assert.Eq(t, linked.MustGet("a"), 1)
+		assert.Eq(t, linked.MustGet("b"), 1)
+	})
+
+	t.Run("moves keys removed and put back to the end", func(t *testing.T) {
+		linked := hashmap.NewLinked(hashmap.Pair("a", 0), hashmap.Pair("b", 0), hashmap.Pair("c", 0))
+		assert.DeepEqual(t, keys(linked.Remove("a").Put("a", 0)), []string{"b", "c", "a"})
+		assert.DeepEqual(t, keys(linked), []string{"a", "b", "c"})
+	})
+
+	t.Run("iterates the same way every time", func(t *testing.T) {
+		linked := hashmap.NewLinked[string, int]()
+		expected := make([]string, 0, 100)
+
+		for i := 0; i < 100; i++ {
+			key := string(rune('A' + i))
+			linked = linked.Put(key, i)
+			expected = append(expected, key)
+		}
+
+		for i := 0; i < 10; i++ {
+			assert.DeepEqual(t, keys(linked), expected)
+		}
+	})
+
+	t.Run("ranges in the order keys were put in", func(t *testing.T) {
+		linked := hashmap.NewLinked[string, int]().Put("c", 0).Put("a", 1).Put("b", 2)
+		var ranged []string
+		for key, value := range linked.All() {
+			ranged = append(ranged, key+strconv.Itoa(value))
+		}
+
+		assert.DeepEqual(t, ranged, []string{"c0", "a1", "b2"})
+		assert.DeepEqual(t, maps.Collect(linked.All()), map[string]int{"a": 1, "b": 2, "c": 0})
+	})
+
+	t.Run("is comparable regardless of order", func(t *testing.T) {
+		linkedA := hashmap.NewLinked(hashmap.Pair("a", 1), hashmap.Pair("b", 2))
+		linkedB := hashmap.NewLinked(hashmap.Pair("b", 2), hashmap.Pair("a", 1))
+		assert.Equals(t, linkedA, linkedB)
+		assert.Eq(t, linkedA.Hash(), linkedB.Hash())
+		assert.NotEquals(t, linkedA, linkedB.Put("a", 0))
+		assert.NotEquals(t, linkedA, linkedB.Remove("a"))
+	})
+
+	t.Run("shares the same hash with equal linked hash maps", func(t *testing.T) {
+		valueA, valueB := 1, 1
+		linked := hashmap.NewLinked(hashmap.Pair("a", &valueA))
+
+		assert.Equals(t, linked, hashmap.NewLinked(hashmap.Pair("a", &valueA)))
+		assert.Eq(t, linked.Hash(), hashmap.NewLinked(hashmap.Pair("a", &valueA)).Hash())
+		assert.NotEquals(t, linked, hashmap.NewLinked(hashmap.Pair("a", &valueB)))
+	})
+}
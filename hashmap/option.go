@@ -0,0 +1,25 @@
+package hashmap
+
+import (
+	"github.com/gtramontina/go-extlib/internal/hash"
+	"github.com/gtramontina/go-extlib/internal/table"
+)
+
+// Option customises how a HashMap tells its keys apart.
+type Option = hash.Option
+
+// FollowPointers makes a HashMap tell pointer keys apart by the values they
+// point to, rather than by their addresses. Nil pointers are only equal to
+// other nil pointers, and values referring back to themselves through pointers
+// are equal when their cycles go equally far back. Values are then compared the
+// same way by Equals.
+func FollowPointers() Option {
+	return hash.FollowPointers()
+}
+
+// With creates an empty HashMap telling its keys apart as the given options
+// describe. HashMaps derived from it, through Put, FilterEntries, MapValues and
+// the like, as well as Builders starting off with it, keep its options.
+func With[Key any, Value any](options ...Option) HashMap[Key, Value] {
+	return HashMap[Key, Value]{entries: table.With[Key, Value](options...)}
+}
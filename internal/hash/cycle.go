@@ -0,0 +1,111 @@
+package hash
+
+import (
+	"reflect"
+	"sync"
+)
+
+// reference identifies a slice, map or pointer being walked through. Slices
+// sharing the same backing array but having different lengths are different
+// references.
+type reference struct {
+	pointer uintptr
+	length  int
+	kind    reflect.Type
+}
+
+func referenceTo(value reflect.Value) reference {
+	length := 0
+	if value.Kind() == reflect.Slice {
+		length = value.Len()
+	}
+
+	return reference{pointer: value.Pointer(), length: length, kind: value.Type()}
+}
+
+// path holds the references leading to the value currently being walked
+// through, so that walking back into any of them can be told apart from
+// walking into a new value.
+type path []reference
+
+// indexOf returns how far back the given reference was walked through, or -1
+// if it is not part of this path.
+func (p path) indexOf(ref reference) int {
+	for i := len(p) - 1; i >= 0; i-- {
+		if p[i] == ref {
+			return len(p) - i
+		}
+	}
+
+	return -1
+}
+
+// tracks tells whether the given value is a reference that must be kept track
+// of in order to detect cycles.
+func (c config) tracks(value reflect.Value) bool {
+	switch value.Kind() { //nolint:exhaustive // only references may cycle
+	case reflect.Map, reflect.Slice:
+		return value.Len() > 0 && mayCycle(value.Type(), c)
+	case reflect.Pointer:
+		return c.followPointers && !value.IsNil() && mayCycle(value.Type(), c)
+	default:
+		return false
+	}
+}
+
+//nolint:gochecknoglobals // caches are shared by all calls
+var cyclicTypes = [2]sync.Map{}
+
+// mayCycle tells whether values of the given type are able to refer back to
+// themselves. Only those need to be tracked while walking through values, which
+// keeps hashing acyclic types, like []int, free of allocations.
+func mayCycle(kind reflect.Type, c config) bool {
+	cache := &cyclicTypes[0]
+	if c.followPointers {
+		cache = &cyclicTypes[1]
+	}
+
+	if cached, ok := cache.Load(kind); ok {
+		return cached.(bool)
+	}
+
+	cyclic := reachesItself(kind, c, nil)
+	cache.Store(kind, cyclic)
+
+	return cyclic
+}
+
+// reachesItself walks through the given type looking for an interface, which
+// may hold anything, or for a type that contains itself.
+//
+//nolint:cyclop // need to cover all composite types
+func reachesItself(kind reflect.Type, c config, visiting []reflect.Type) bool {
+	for _, visited := range visiting {
+		if visited == kind {
+			return true
+		}
+	}
+
+	visiting = append(visiting, kind)
+
+	switch kind.Kind() { //nolint:exhaustive // only composite types may cycle
+	case reflect.Interface:
+		return true
+	case reflect.Pointer:
+		return c.followPointers && reachesItself(kind.Elem(), c, visiting)
+	case reflect.Array, reflect.Slice:
+		return reachesItself(kind.Elem(), c, visiting)
+	case reflect.Map:
+		return reachesItself(kind.Key(), c, visiting) || reachesItself(kind.Elem(), c, visiting)
+	case reflect.Struct:
+		for i := 0; i < kind.NumField(); i++ {
+			if reachesItself(kind.Field(i).Type, c, visiting) {
+				return true
+			}
+		}
+
+		return false
+	default:
+		return false
+	}
+}
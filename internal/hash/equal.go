@@ -0,0 +1,179 @@
+package hash
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+)
+
+// Equal checks whether the two given values are equal following the same rules
+// Calc uses to compute hashes: values of different types are never equal;
+// values implementing Equaler decide for themselves; arrays, slices, maps and
+// structs (including unexported fields) are compared member by member; and
+// channels, functions and pointers are compared by address, unless
+// FollowPointers is given for pointers. Two values
+// considered equal always share the same hash, given the same options. Floats
+// follow the IEEE 754 equality, except for NaNs, which are considered equal to
+// each other. Values referring back to themselves are equal when their cycles
+// go equally far back. See also: Calc, FollowPointers.
+func Equal(left any, right any, options ...Option) bool {
+	if left == nil || right == nil {
+		return left == nil && right == nil
+	}
+
+	c := comparer{config: newConfig(options), leftPath: nil, rightPath: nil}
+
+	return c.equalValues(reflect.ValueOf(left), reflect.ValueOf(right))
+}
+
+// comparer walks through two values side by side, comparing them.
+type comparer struct {
+	config    config
+	leftPath  path
+	rightPath path
+}
+
+//nolint:cyclop,funlen // need to cover all types
+func (c *comparer) equalValues(left reflect.Value, right reflect.Value) bool {
+	if left.Type() != right.Type() {
+		return false
+	}
+
+	left, right = accessible(left), accessible(right)
+
+	if left.Kind() != reflect.Interface && !isNilPointer(left) && !isNilPointer(right) {
+		if equals, ok := equalsMethod(left); ok {
+			return equals.Call([]reflect.Value{right})[0].Bool()
+		}
+	}
+
+	if tracksLeft, tracksRight := c.config.tracks(left), c.config.tracks(right); tracksLeft || tracksRight {
+		if tracksLeft != tracksRight {
+			return false
+		}
+
+		leftRef, rightRef := referenceTo(left), referenceTo(right)
+		leftDistance, rightDistance := c.leftPath.indexOf(leftRef), c.rightPath.indexOf(rightRef)
+
+		if leftDistance >= 0 || rightDistance >= 0 {
+			return leftDistance == rightDistance
+		}
+
+		c.leftPath, c.rightPath = append(c.leftPath, leftRef), append(c.rightPath, rightRef)
+		defer func() {
+			c.leftPath, c.rightPath = c.leftPath[:len(c.leftPath)-1], c.rightPath[:len(c.rightPath)-1]
+		}()
+	}
+
+	switch left.Kind() { //nolint:exhaustive // covering with a default panic
+	case reflect.Bool:
+		return left.Bool() == right.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return left.Int() == right.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return left.Uint() == right.Uint()
+	case reflect.Float32, reflect.Float64:
+		return equalFloat(left.Float(), right.Float())
+	case reflect.Complex64, reflect.Complex128:
+		l, r := left.Complex(), right.Complex()
+
+		return equalFloat(real(l), real(r)) && equalFloat(imag(l), imag(r))
+	case reflect.Array, reflect.Slice:
+		return c.equalSequence(left, right)
+	case reflect.Pointer:
+		return c.equalPointer(left, right)
+	case reflect.Chan, reflect.Func:
+		return left.Pointer() == right.Pointer()
+	case reflect.Interface:
+		return c.equalInterface(left, right)
+	case reflect.Map:
+		return c.equalMap(left, right)
+	case reflect.String:
+		return left.String() == right.String()
+	case reflect.Struct:
+		return c.equalStruct(left, right)
+	default:
+		panic(fmt.Sprintf(`can't check equality for "%s": %+v`, left.Type(), left))
+	}
+}
+
+// equalsMethod looks up the Equals method of the given value, as described by
+// Equaler. Since Equaler is generic, it cannot be type-asserted against.
+func equalsMethod(value reflect.Value) (reflect.Value, bool) {
+	methodType, found := value.Type().MethodByName("Equals")
+	if !found || !value.CanInterface() {
+		return reflect.Value{}, false
+	}
+
+	signature := methodType.Type
+	matches := signature.NumIn() == 2 && signature.NumOut() == 1 && //nolint:mnd // receiver + argument
+		value.Type().AssignableTo(signature.In(1)) && signature.Out(0).Kind() == reflect.Bool
+
+	if !matches {
+		return reflect.Value{}, false
+	}
+
+	return value.Method(methodType.Index), true
+}
+
+func equalFloat(left float64, right float64) bool {
+	return left == right || (math.IsNaN(left) && math.IsNaN(right))
+}
+
+func (c *comparer) equalSequence(left reflect.Value, right reflect.Value) bool {
+	if left.Len() != right.Len() {
+		return false
+	}
+
+	for i := 0; i < left.Len(); i++ {
+		if !c.equalValues(left.Index(i), right.Index(i)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (c *comparer) equalPointer(left reflect.Value, right reflect.Value) bool {
+	if !c.config.followPointers || left.IsNil() || right.IsNil() {
+		return left.Pointer() == right.Pointer()
+	}
+
+	return c.equalValues(left.Elem(), right.Elem())
+}
+
+func (c *comparer) equalInterface(left reflect.Value, right reflect.Value) bool {
+	if left.IsNil() || right.IsNil() {
+		return left.IsNil() && right.IsNil()
+	}
+
+	return c.equalValues(left.Elem(), right.Elem())
+}
+
+func (c *comparer) equalMap(left reflect.Value, right reflect.Value) bool {
+	if left.Len() != right.Len() {
+		return false
+	}
+
+	iter := left.MapRange()
+	for iter.Next() {
+		rightValue := right.MapIndex(iter.Key())
+		if !rightValue.IsValid() || !c.equalValues(iter.Value(), rightValue) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (c *comparer) equalStruct(left reflect.Value, right reflect.Value) bool {
+	left, right = addressable(left), addressable(right)
+
+	for i := 0; i < left.NumField(); i++ {
+		if !c.equalValues(left.Field(i), right.Field(i)) {
+			return false
+		}
+	}
+
+	return true
+}
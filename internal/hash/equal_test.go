@@ -0,0 +1,197 @@
+package hash_test
+
+import (
+	"math"
+	"testing"
+	"unsafe"
+
+	"github.com/gtramontina/go-extlib/internal/hash"
+	"github.com/gtramontina/go-extlib/testing/assert"
+)
+
+func TestEqual(t *testing.T) {
+	t.Run("unknown type", func(t *testing.T) {
+		assert.PanicsWith(t, func() { hash.Equal(unsafe.Pointer(nil), unsafe.Pointer(nil)) }, `can't check equality for "unsafe.Pointer": <nil>`)
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		assert.True(t, hash.Equal(nil, nil))
+		assert.False(t, hash.Equal(nil, 0))
+		assert.False(t, hash.Equal(0, nil))
+	})
+
+	t.Run("different types", func(t *testing.T) {
+		assert.False(t, hash.Equal(0, int8(0)))
+		assert.False(t, hash.Equal(0, uint(0)))
+		assert.False(t, hash.Equal("0", 0))
+		assert.False(t, hash.Equal([]int{}, []string{}))
+	})
+
+	t.Run("primitives", func(t *testing.T) {
+		assert.True(t, hash.Equal(true, true))
+		assert.False(t, hash.Equal(true, false))
+		assert.True(t, hash.Equal(1, 1))
+		assert.False(t, hash.Equal(1, 2))
+		assert.True(t, hash.Equal(uint8(1), uint8(1)))
+		assert.False(t, hash.Equal(uint8(1), uint8(2)))
+		assert.True(t, hash.Equal(uintptr(1), uintptr(1)))
+		assert.False(t, hash.Equal(uintptr(1), uintptr(2)))
+		assert.True(t, hash.Equal("a", "a"))
+		assert.False(t, hash.Equal("a", "b"))
+	})
+
+	t.Run("floats", func(t *testing.T) {
+		assert.True(t, hash.Equal(0.1, 0.1))
+		assert.False(t, hash.Equal(0.0000001, 0.0000002))
+		assert.True(t, hash.Equal(math.NaN(), math.NaN()))
+		assert.True(t, hash.Equal(math.Copysign(0, -1), 0.0))
+		assert.False(t, hash.Equal(math.NaN(), 0.0))
+		assert.True(t, hash.Equal(complex(1, 2), complex(1, 2)))
+		assert.False(t, hash.Equal(complex(1, 2), complex(2, 1)))
+	})
+
+	t.Run("arrays and slices", func(t *testing.T) {
+		assert.True(t, hash.Equal([2]int{0, 1}, [2]int{0, 1}))
+		assert.False(t, hash.Equal([2]int{0, 1}, [2]int{1, 0}))
+		assert.True(t, hash.Equal([]int{}, []int{}))
+		assert.True(t, hash.Equal([]int(nil), []int{}))
+		assert.True(t, hash.Equal([]int{0, 1}, []int{0, 1}))
+		assert.False(t, hash.Equal([]int{0, 1}, []int{0}))
+		assert.False(t, hash.Equal([]int{0, 1}, []int{1, 0}))
+		assert.True(t, hash.Equal([]any{1, "a"}, []any{1, "a"}))
+		assert.False(t, hash.Equal([]any{1, "a"}, []any{"a", 1}))
+	})
+
+	t.Run("maps", func(t *testing.T) {
+		assert.True(t, hash.Equal(map[int]int{}, map[int]int{}))
+		assert.True(t, hash.Equal(map[int]int{0: 0, 1: 1}, map[int]int{1: 1, 0: 0}))
+		assert.False(t, hash.Equal(map[int]int{0: 0}, map[int]int{0: 1}))
+		assert.False(t, hash.Equal(map[int]int{0: 0}, map[int]int{1: 0}))
+		assert.False(t, hash.Equal(map[int]int{0: 0}, map[int]int{0: 0, 1: 1}))
+	})
+
+	t.Run("structs", func(t *testing.T) {
+		type unexported struct {
+			field1 int
+			field2 []string
+		}
+
+		type other struct {
+			field1 int
+			field2 []string
+		}
+
+		assert.True(t, hash.Equal(unexported{}, unexported{}))
+		assert.True(t, hash.Equal(unexported{1, []string{"a"}}, unexported{1, []string{"a"}}))
+		assert.False(t, hash.Equal(unexported{1, []string{"a"}}, unexported{1, []string{"b"}}))
+		assert.False(t, hash.Equal(unexported{1, nil}, unexported{2, nil}))
+		assert.False(t, hash.Equal(unexported{}, other{}))
+	})
+
+	t.Run("references", func(t *testing.T) {
+		valueA, valueB := 1, 1
+		assert.True(t, hash.Equal(&valueA, &valueA))
+		assert.False(t, hash.Equal(&valueA, &valueB))
+
+		chanA, chanB := make(chan int), make(chan int)
+		assert.True(t, hash.Equal(chanA, chanA))
+		assert.False(t, hash.Equal(chanA, chanB))
+
+		funcA, funcB := func() {}, func() {}
+		assert.True(t, hash.Equal(funcA, funcA))
+		assert.False(t, hash.Equal(funcA, funcB))
+	})
+
+	t.Run("interfaces", func(t *testing.T) {
+		type holder struct{ value any }
+		assert.True(t, hash.Equal(holder{1}, holder{1}))
+		assert.True(t, hash.Equal(holder{nil}, holder{}))
+		assert.False(t, hash.Equal(holder{1}, holder{int8(1)}))
+		assert.False(t, hash.Equal(holder{nil}, holder{0}))
+		assert.False(t, hash.Equal(holder{0}, holder{nil}))
+	})
+
+	t.Run("pointees", func(t *testing.T) {
+		valueA, valueB, valueC := 1, 1, 2
+		assert.True(t, hash.Equal(&valueA, &valueB, hash.FollowPointers()))
+		assert.False(t, hash.Equal(&valueA, &valueC, hash.FollowPointers()))
+		assert.True(t, hash.Equal((*int)(nil), (*int)(nil), hash.FollowPointers()))
+		assert.False(t, hash.Equal((*int)(nil), &valueA, hash.FollowPointers()))
+		assert.False(t, hash.Equal(&valueA, (*int)(nil), hash.FollowPointers()))
+	})
+
+	t.Run("cycles", func(t *testing.T) {
+		selfContainingA := []any{1, nil}
+		selfContainingA[1] = selfContainingA
+		selfContainingB := []any{1, nil}
+		selfContainingB[1] = selfContainingB
+		selfContainingC := []any{2, nil}
+		selfContainingC[1] = selfContainingC
+		assert.True(t, hash.Equal(selfContainingA, selfContainingA))
+		assert.True(t, hash.Equal(selfContainingA, selfContainingB))
+		assert.False(t, hash.Equal(selfContainingA, selfContainingC))
+
+		type node struct {
+			value int
+			next  *node
+		}
+
+		ringOfOneA := &node{value: 1}
+		ringOfOneA.next = ringOfOneA
+		ringOfOneB := &node{value: 1}
+		ringOfOneB.next = ringOfOneB
+		ringOfTwo := &node{value: 1, next: &node{value: 1}}
+		ringOfTwo.next.next = ringOfTwo
+		assert.True(t, hash.Equal(ringOfOneA, ringOfOneB, hash.FollowPointers()))
+		assert.False(t, hash.Equal(ringOfOneA, ringOfTwo, hash.FollowPointers()))
+		assert.False(t, hash.Equal(ringOfTwo, ringOfOneA, hash.FollowPointers()))
+	})
+
+	t.Run("equaler", func(t *testing.T) {
+		assert.True(t, hash.Equal(account{1, nil}, account{1, map[string]string{"a": "b"}}))
+		assert.False(t, hash.Equal(account{1, nil}, account{2, nil}))
+
+		type holder struct{ account account }
+		assert.True(t, hash.Equal(holder{account{1, nil}}, holder{account{1, map[string]string{"a": "b"}}}))
+		assert.False(t, hash.Equal(holder{account{1, nil}}, holder{account{2, nil}}))
+	})
+
+	t.Run("nil pointers to equalers", func(t *testing.T) {
+		assert.True(t, hash.Equal((*account)(nil), (*account)(nil)))
+		assert.False(t, hash.Equal((*account)(nil), &account{1, nil}))
+		assert.False(t, hash.Equal(&account{1, nil}, (*account)(nil), hash.FollowPointers()))
+
+		type holder struct{ account *account }
+		assert.True(t, hash.Equal(holder{nil}, holder{nil}))
+		assert.True(t, hash.Equal(holder{nil}, holder{nil}, hash.FollowPointers()))
+		assert.False(t, hash.Equal(holder{nil}, holder{&account{1, nil}}))
+	})
+
+	t.Run("equalers nested behind unexported fields", func(t *testing.T) {
+		type key struct{ id int }
+		type holder struct {
+			accounts map[key]account
+			any      any
+			array    [2]account
+		}
+
+		cached := map[string]string{"a": "b"}
+		valueA := holder{map[key]account{{1}: {1, nil}}, account{2, nil}, [2]account{{3, nil}, {4, nil}}}
+		valueB := holder{map[key]account{{1}: {1, cached}}, account{2, cached}, [2]account{{3, cached}, {4, nil}}}
+		assert.True(t, hash.Equal(valueA, valueB))
+		assert.True(t, hash.Equal([]holder{valueA}, []holder{valueB}))
+		assert.Eq(t, hash.Calc(valueA), hash.Calc(valueB))
+	})
+
+	t.Run("equal values share the same hash", func(t *testing.T) {
+		type sample struct {
+			name string
+			tags map[string]bool
+		}
+
+		valueA := sample{"a", map[string]bool{"x": true, "y": false}}
+		valueB := sample{"a", map[string]bool{"y": false, "x": true}}
+		assert.True(t, hash.Equal(valueA, valueB))
+		assert.Eq(t, hash.Calc(valueA), hash.Calc(valueB))
+	})
+}
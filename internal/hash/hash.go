@@ -2,120 +2,248 @@ package hash
 
 import (
 	"fmt"
-	"hash/fnv"
+	"math"
 	"reflect"
 )
 
-const prime = 31
+// Hashable is implemented by types that define their own hash code, allowing
+// them to decide what identifies their values instead of having all of their
+// fields considered. Calc honours it before falling back to reflection. Types
+// implementing Hashable should also implement Equaler, so that values deemed
+// equal always share the same hash code.
+type Hashable interface {
+	Hash() uint64
+}
 
-//nolint:funlen,cyclop // need to cover all types
-func Calc(subject any) uint64 {
+// Equaler is implemented by types that define their own equality. Equal honours
+// it before falling back to reflection. See also: Hashable.
+type Equaler[Type any] interface {
+	Equals(Type) bool
+}
+
+var hashableType = reflect.TypeOf((*Hashable)(nil)).Elem()
+
+// cycleTag marks a value referring back to one of the values containing it. It
+// is distinct from all reflect.Kind values, which tag all other values.
+const cycleTag = 0xff
+
+// Calc calculates the hash code of the given subject. Hashable subjects provide
+// their own hash code; any other subject is hashed based on its type and on
+// its contents, walking through arrays, maps, slices and structs (including
+// unexported fields). Interfaces are hashed by the dynamic type and value they
+// hold, so that an `any` holding int(1) and one holding int8(1) differ, while
+// nil interfaces share a hash code of their own. Channels, functions and
+// pointers are hashed by address, unless FollowPointers is given, in which case
+// pointers are hashed by the values they point to.
+//
+// Values are hashed by their binary representation rather than by their text
+// rendering. Floats, in particular, are hashed by their bits, with two
+// exceptions matching Equal: -0 is hashed as 0, and all NaNs share the same
+// hash code.
+//
+// Values referring back to themselves, like a slice containing itself or, when
+// following pointers, a self-referential struct, are hashed by how far back
+// the cycle goes rather than being walked through indefinitely.
+func Calc(subject any, options ...Option) uint64 {
 	if subject == nil {
 		return 0
 	}
 
-	value := reflect.ValueOf(subject)
+	if hashable, ok := subject.(Hashable); ok && !isNilPointer(reflect.ValueOf(subject)) {
+		return hashable.Hash()
+	}
+
+	w := walker{hasher: newHasher(), config: newConfig(options), path: nil}
+	w.writeValue(reflect.ValueOf(subject))
+
+	return uint64(w.hasher)
+}
+
+// walker walks through values, writing them into its hasher.
+type walker struct {
+	hasher hasher
+	config config
+	path   path
+}
+
+//nolint:funlen,cyclop // need to cover all types
+func (w *walker) writeValue(value reflect.Value) {
+	value = accessible(value)
+
+	if value.Kind() != reflect.Interface && !isNilPointer(value) && value.Type().Implements(hashableType) {
+		w.hasher.writeByte(byte(value.Kind()))
+		w.hasher.writeUint64(value.Interface().(Hashable).Hash())
+
+		return
+	}
+
+	if w.config.tracks(value) {
+		ref := referenceTo(value)
+		if distance := w.path.indexOf(ref); distance >= 0 {
+			w.hasher.writeByte(cycleTag)
+			w.hasher.writeUint64(uint64(distance))
+
+			return
+		}
+
+		w.path = append(w.path, ref)
+		defer func() { w.path = w.path[:len(w.path)-1] }()
+	}
+
+	w.hasher.writeByte(byte(value.Kind()))
+
 	switch value.Kind() { //nolint:exhaustive // covering with a default panic
 	case reflect.Bool:
-		return hash(fmt.Sprintf("bool:%v", value.Bool()))
-	case reflect.Int:
-		return hash(fmt.Sprintf("int:%d", value.Int()))
-	case reflect.Int8:
-		return hash(fmt.Sprintf("int8:%d", value.Int()))
-	case reflect.Int16:
-		return hash(fmt.Sprintf("int16:%d", value.Int()))
-	case reflect.Int32:
-		return hash(fmt.Sprintf("int32:%d", value.Int()))
-	case reflect.Int64:
-		return hash(fmt.Sprintf("int64:%d", value.Int()))
-	case reflect.Uint:
-		return hash(fmt.Sprintf("uint:%d", value.Uint()))
-	case reflect.Uint8:
-		return hash(fmt.Sprintf("uint8:%d", value.Uint()))
-	case reflect.Uint16:
-		return hash(fmt.Sprintf("uint16:%d", value.Uint()))
-	case reflect.Uint32:
-		return hash(fmt.Sprintf("uint32:%d", value.Uint()))
-	case reflect.Uint64:
-		return hash(fmt.Sprintf("uint64:%d", value.Uint()))
-	case reflect.Float32:
-		return hash(fmt.Sprintf("float32:%f", value.Float()))
-	case reflect.Float64:
-		return hash(fmt.Sprintf("float64:%f", value.Float()))
-	case reflect.Complex64:
-		return hash(fmt.Sprintf("complex64:%f", value.Complex()))
-	case reflect.Complex128:
-		return hash(fmt.Sprintf("complex128:%f", value.Complex()))
-	case reflect.Array:
-		return hashArray(value)
-	case reflect.Chan:
-		return hash(fmt.Sprintf("chan:%d", value.UnsafePointer()))
-	case reflect.Func:
-		return hash(fmt.Sprintf("func:%d", value.UnsafePointer()))
-	case reflect.Map:
-		return hashMap(value)
+		if value.Bool() {
+			w.hasher.writeByte(1)
+		} else {
+			w.hasher.writeByte(0)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		w.hasher.writeUint64(uint64(value.Int()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		w.hasher.writeUint64(value.Uint())
+	case reflect.Float32, reflect.Float64:
+		w.hasher.writeUint64(floatBits(value.Float()))
+	case reflect.Complex64, reflect.Complex128:
+		w.hasher.writeUint64(floatBits(real(value.Complex())))
+		w.hasher.writeUint64(floatBits(imag(value.Complex())))
+	case reflect.Array, reflect.Slice:
+		w.writeSequence(value)
 	case reflect.Pointer:
-		return hash(fmt.Sprintf("pointer:%d", value.UnsafePointer()))
-	case reflect.Slice:
-		return hashSlice(value)
+		w.writePointer(value)
+	case reflect.Chan, reflect.Func:
+		w.hasher.writeString(value.Type().String())
+		w.hasher.writeUint64(uint64(value.Pointer()))
+	case reflect.Interface:
+		w.writeInterface(value)
+	case reflect.Map:
+		w.writeMap(value)
 	case reflect.String:
-		return hash(fmt.Sprintf("string:%s", value.String()))
+		w.hasher.writeString(value.String())
 	case reflect.Struct:
-		return hashStruct(value)
+		w.writeStruct(value)
 	default:
-		panic(fmt.Sprintf(`can't calculate hash for "%s": %+v`, value.Type(), subject))
+		panic(fmt.Sprintf(`can't calculate hash for "%s": %+v`, value.Type(), value))
 	}
 }
 
-func hashArray(value reflect.Value) uint64 {
-	h := hash(fmt.Sprintf("array:%s", value.Type().Elem()))
+func (w *walker) writeSequence(value reflect.Value) {
+	w.hasher.writeString(value.Type().String())
+	w.hasher.writeUint64(uint64(value.Len()))
+
 	for i := 0; i < value.Len(); i++ {
-		h = prime*h + Calc(value.Index(i).Interface())
+		w.writeValue(value.Index(i))
 	}
+}
+
+func (w *walker) writePointer(value reflect.Value) {
+	w.hasher.writeString(value.Type().String())
+
+	if !w.config.followPointers {
+		w.hasher.writeUint64(uint64(value.Pointer()))
+
+		return
+	}
+
+	if value.IsNil() {
+		w.hasher.writeByte(0)
 
-	return h
+		return
+	}
+
+	w.hasher.writeByte(1)
+	w.writeValue(value.Elem())
 }
 
-func hashMap(value reflect.Value) uint64 {
-	calculatedHash := hash(fmt.Sprintf("map:%s:%s", value.Type().Key(), value.Type().Elem()))
-	iter := value.MapRange()
+func (w *walker) writeInterface(value reflect.Value) {
+	if value.IsNil() {
+		w.hasher.writeByte(0)
+
+		return
+	}
+
+	w.writeValue(value.Elem())
+}
+
+// writeMap combines the hash codes of all key/value pairs in a way that does
+// not depend on the order they are iterated over.
+func (w *walker) writeMap(value reflect.Value) {
+	w.hasher.writeString(value.Type().String())
+	w.hasher.writeUint64(uint64(value.Len()))
 
+	var combined uint64
+
+	iter := value.MapRange()
 	for iter.Next() {
-		pairH := Calc(iter.Key().Interface())
-		pairH = prime*pairH + Calc(iter.Value().Interface())
-		calculatedHash ^= pairH
+		pair := walker{hasher: newHasher(), config: w.config, path: w.path}
+		pair.writeValue(iter.Key())
+		pair.writeValue(iter.Value())
+		combined ^= uint64(pair.hasher)
 	}
 
-	return calculatedHash
+	w.hasher.writeUint64(combined)
 }
 
-func hashSlice(value reflect.Value) uint64 {
-	h := hash(fmt.Sprintf("slice:%s", value.Type().Elem()))
-	for i := 0; i < value.Len(); i++ {
-		h = prime*h + Calc(value.Index(i).Interface())
+func (w *walker) writeStruct(value reflect.Value) {
+	value = addressable(value)
+	w.hasher.writeString(value.Type().String())
+
+	for i := 0; i < value.NumField(); i++ {
+		w.writeValue(value.Field(i))
 	}
+}
 
-	return h
+// floatBits returns the bits representing the given float, treating -0 as 0 and
+// all NaNs as the same NaN.
+func floatBits(value float64) uint64 {
+	switch {
+	case value == 0:
+		return 0
+	case math.IsNaN(value):
+		return math.Float64bits(math.NaN())
+	default:
+		return math.Float64bits(value)
+	}
 }
 
-func hashStruct(value reflect.Value) uint64 {
-	calculatedHash := hash(fmt.Sprintf("struct:%s", value.Type()))
+// isNilPointer tells whether the given value is a nil pointer, whose Hash and
+// Equals methods cannot be called when declared on the value it points to.
+func isNilPointer(value reflect.Value) bool {
+	return value.Kind() == reflect.Pointer && value.IsNil()
+}
 
-	addressableCopy := reflect.New(value.Type()).Elem()
-	addressableCopy.Set(value)
+// accessible lifts the read-only restriction reflection imposes on values
+// obtained through unexported struct fields, allowing their methods to be
+// called. Only addressable values can be lifted, hence addressable.
+func accessible(value reflect.Value) reflect.Value {
+	if value.CanInterface() || !value.CanAddr() {
+		return value
+	}
 
-	for i := 0; i < value.NumField(); i++ {
-		field := addressableCopy.Field(i)
-		field = reflect.NewAt(field.Type(), field.Addr().UnsafePointer()).Elem()
-		calculatedHash = prime*calculatedHash + Calc(field.Interface())
+	return reflect.NewAt(value.Type(), value.Addr().UnsafePointer()).Elem()
+}
+
+// addressable returns an addressable copy of the given struct when it has
+// unexported fields and is not addressable already, so that those fields can
+// be made accessible. This is the only case where hashing allocates.
+func addressable(value reflect.Value) reflect.Value {
+	if value.CanAddr() || !hasReadOnlyFields(value) {
+		return value
 	}
 
-	return calculatedHash
+	addressableCopy := reflect.New(value.Type()).Elem()
+	addressableCopy.Set(value)
+
+	return addressableCopy
 }
 
-func hash(s string) uint64 {
-	h := fnv.New64()
-	_, _ = h.Write([]byte(s)) // fnv.sum64 never errors
+func hasReadOnlyFields(value reflect.Value) bool {
+	for i := 0; i < value.NumField(); i++ {
+		if !value.Field(i).CanInterface() {
+			return true
+		}
+	}
 
-	return h.Sum64()
+	return false
 }
@@ -1,15 +1,25 @@
 package hash_test
 
 import (
+	"math"
 	"testing"
+	"unsafe"
 
 	"github.com/gtramontina/go-extlib/internal/hash"
 	"github.com/gtramontina/go-extlib/testing/assert"
 )
 
+type account struct {
+	id    int
+	cache map[string]string
+}
+
+func (a account) Hash() uint64              { return uint64(a.id) }
+func (a account) Equals(other account) bool { return a.id == other.id }
+
 func TestHash(t *testing.T) {
 	t.Run("unknown type", func(t *testing.T) {
-		assert.PanicsWith(t, func() { hash.Calc(uintptr(0)) }, `can't calculate hash for "uintptr": 0`)
+		assert.PanicsWith(t, func() { hash.Calc(unsafe.Pointer(nil)) }, `can't calculate hash for "unsafe.Pointer": <nil>`)
 	})
 
 	t.Run("nil", func(t *testing.T) {
@@ -121,6 +131,13 @@ func TestHash(t *testing.T) {
 		assert.NotEq(t, hash.Calc(uint64(0)), hash.Calc(uint64(1)))
 	})
 
+	t.Run("uintptr", func(t *testing.T) {
+		assert.Eq(t, hash.Calc(uintptr(0)), hash.Calc(uintptr(0)))
+		assert.Eq(t, hash.Calc(uintptr(1)), hash.Calc(uintptr(1)))
+		assert.NotEq(t, hash.Calc(uintptr(0)), hash.Calc(uintptr(1)))
+		assert.NotEq(t, hash.Calc(uintptr(1)), hash.Calc(uint(1)))
+	})
+
 	t.Run("float32", func(t *testing.T) {
 		assert.Eq(t, hash.Calc(float32(-1)), hash.Calc(float32(-1)))
 		assert.Eq(t, hash.Calc(float32(0)), hash.Calc(float32(0)))
@@ -131,6 +148,13 @@ func TestHash(t *testing.T) {
 	})
 
 	t.Run("float64", func(t *testing.T) {
+		assert.NotEq(t, hash.Calc(0.0000001), hash.Calc(0.0000002))
+		assert.NotEq(t, hash.Calc(math.SmallestNonzeroFloat64), hash.Calc(0.0))
+		assert.Eq(t, hash.Calc(math.Copysign(0, -1)), hash.Calc(0.0))
+		assert.Eq(t, hash.Calc(math.NaN()), hash.Calc(math.NaN()))
+		assert.Eq(t, hash.Calc(math.NaN()), hash.Calc(-math.NaN()))
+		assert.NotEq(t, hash.Calc(math.NaN()), hash.Calc(math.Inf(1)))
+		assert.NotEq(t, hash.Calc(math.Inf(-1)), hash.Calc(math.Inf(1)))
 		assert.Eq(t, hash.Calc(float64(-1)), hash.Calc(float64(-1)))
 		assert.Eq(t, hash.Calc(float64(0)), hash.Calc(float64(0)))
 		assert.Eq(t, hash.Calc(float64(1)), hash.Calc(float64(1)))
@@ -336,4 +360,125 @@ func TestHash(t *testing.T) {
 		assert.Eq(t, hash.Calc(&mapB), hash.Calc(&mapB))
 		assert.NotEq(t, hash.Calc(&mapA), hash.Calc(&mapB))
 	})
+	t.Run("interface", func(t *testing.T) {
+		type holder struct{ value any }
+		assert.Eq(t, hash.Calc(holder{1}), hash.Calc(holder{1}))
+		assert.NotEq(t, hash.Calc(holder{1}), hash.Calc(holder{int8(1)}))
+		assert.NotEq(t, hash.Calc(holder{1}), hash.Calc(holder{"1"}))
+		assert.NotEq(t, hash.Calc(holder{nil}), hash.Calc(holder{0}))
+		assert.Eq(t, hash.Calc(holder{nil}), hash.Calc(holder{}))
+		assert.Eq(t, hash.Calc([]any{1, "a", nil}), hash.Calc([]any{1, "a", nil}))
+		assert.NotEq(t, hash.Calc([]any{1, "a"}), hash.Calc([]any{"a", 1}))
+		assert.NotEq(t, hash.Calc([]any{1}), hash.Calc([]int{1}))
+		assert.Eq(t, hash.Calc(map[string]any{"a": []any{1}}), hash.Calc(map[string]any{"a": []any{1}}))
+	})
+
+	t.Run("pointer by pointee", func(t *testing.T) {
+		valueA, valueB, valueC := 1, 1, 2
+		assert.Eq(t, hash.Calc(&valueA, hash.FollowPointers()), hash.Calc(&valueB, hash.FollowPointers()))
+		assert.NotEq(t, hash.Calc(&valueA, hash.FollowPointers()), hash.Calc(&valueC, hash.FollowPointers()))
+		assert.NotEq(t, hash.Calc(&valueA), hash.Calc(&valueB))
+		assert.NotEq(t, hash.Calc((*int)(nil), hash.FollowPointers()), hash.Calc(new(int), hash.FollowPointers()))
+
+		type node struct {
+			value int
+			next  *node
+		}
+
+		listA := &node{1, &node{2, nil}}
+		listB := &node{1, &node{2, nil}}
+		listC := &node{1, &node{3, nil}}
+		assert.Eq(t, hash.Calc(listA, hash.FollowPointers()), hash.Calc(listB, hash.FollowPointers()))
+		assert.NotEq(t, hash.Calc(listA, hash.FollowPointers()), hash.Calc(listC, hash.FollowPointers()))
+	})
+
+	t.Run("cycles", func(t *testing.T) {
+		selfContainingA := []any{1, nil}
+		selfContainingA[1] = selfContainingA
+		selfContainingB := []any{1, nil}
+		selfContainingB[1] = selfContainingB
+		selfContainingC := []any{2, nil}
+		selfContainingC[1] = selfContainingC
+		assert.Eq(t, hash.Calc(selfContainingA), hash.Calc(selfContainingA))
+		assert.Eq(t, hash.Calc(selfContainingA), hash.Calc(selfContainingB))
+		assert.NotEq(t, hash.Calc(selfContainingA), hash.Calc(selfContainingC))
+
+		selfContainingMap := map[string]any{}
+		selfContainingMap["self"] = selfContainingMap
+		assert.Eq(t, hash.Calc(selfContainingMap), hash.Calc(selfContainingMap))
+
+		type node struct {
+			value int
+			next  *node
+		}
+
+		ringOfOneA := &node{value: 1}
+		ringOfOneA.next = ringOfOneA
+		ringOfOneB := &node{value: 1}
+		ringOfOneB.next = ringOfOneB
+		ringOfTwo := &node{value: 1, next: &node{value: 1}}
+		ringOfTwo.next.next = ringOfTwo
+		assert.Eq(t, hash.Calc(ringOfOneA, hash.FollowPointers()), hash.Calc(ringOfOneB, hash.FollowPointers()))
+		assert.NotEq(t, hash.Calc(ringOfOneA, hash.FollowPointers()), hash.Calc(ringOfTwo, hash.FollowPointers()))
+	})
+
+	t.Run("hashable", func(t *testing.T) {
+		assert.Eq(t, hash.Calc(account{1, nil}), 1)
+		assert.Eq(t, hash.Calc(account{1, map[string]string{"a": "b"}}), hash.Calc(account{1, nil}))
+		assert.NotEq(t, hash.Calc(account{1, nil}), hash.Calc(account{2, nil}))
+
+		type holder struct{ account account }
+		assert.Eq(t, hash.Calc(holder{account{1, map[string]string{"a": "b"}}}), hash.Calc(holder{account{1, nil}}))
+		assert.Eq(t, hash.Calc([]any{account{1, map[string]string{"a": "b"}}}), hash.Calc([]any{account{1, nil}}))
+	})
+
+	t.Run("nil pointers to hashables", func(t *testing.T) {
+		assert.Eq(t, hash.Calc((*account)(nil)), hash.Calc((*account)(nil)))
+		assert.NotEq(t, hash.Calc((*account)(nil)), hash.Calc(&account{1, nil}))
+		assert.Eq(t, hash.Calc(&account{1, nil}), 1)
+
+		type holder struct{ account *account }
+		assert.Eq(t, hash.Calc(holder{nil}), hash.Calc(holder{nil}))
+		assert.Eq(t, hash.Calc(holder{nil}, hash.FollowPointers()), hash.Calc(holder{nil}, hash.FollowPointers()))
+		assert.NotEq(t, hash.Calc(holder{nil}), hash.Calc(holder{&account{1, nil}}))
+	})
+}
+
+func BenchmarkHash(b *testing.B) {
+	type exported struct {
+		Name string
+		Age  int
+	}
+
+	type unexported struct {
+		name string
+		age  int
+	}
+
+	subjects := []struct {
+		name    string
+		subject any
+	}{
+		{"bool", true},
+		{"int", 42},
+		{"float64", 4.2},
+		{"string", "forty-two"},
+		{"[]int", []int{4, 2}},
+		{"map[string]int", map[string]int{"four": 4, "two": 2}},
+		{"struct with exported fields", exported{"forty-two", 42}},
+		{"struct with unexported fields", unexported{"forty-two", 42}},
+		{"hashable", account{42, nil}},
+	}
+
+	for _, subject := range subjects {
+		subject := subject
+
+		b.Run(subject.name, func(b *testing.B) {
+			b.ReportAllocs()
+
+			for n := 0; n < b.N; n++ {
+				_ = hash.Calc(subject.subject)
+			}
+		})
+	}
 }
@@ -0,0 +1,34 @@
+package hash
+
+const (
+	offset64 = 14695981039346656037
+	prime64  = 1099511628211
+)
+
+// hasher implements the 64-bit FNV-1a hash function. Its whole state fits in a
+// uint64, so values are written straight into it without any allocations.
+type hasher uint64
+
+func newHasher() hasher {
+	return offset64
+}
+
+func (h *hasher) writeByte(b byte) {
+	*h = (*h ^ hasher(b)) * prime64
+}
+
+func (h *hasher) writeUint64(value uint64) {
+	for i := 0; i < 64; i += 8 {
+		h.writeByte(byte(value >> i))
+	}
+}
+
+// writeString writes the length of the given string before its contents, so
+// that sequences of strings can't be confused with one another.
+func (h *hasher) writeString(value string) {
+	h.writeUint64(uint64(len(value)))
+
+	for i := 0; i < len(value); i++ {
+		h.writeByte(value[i])
+	}
+}
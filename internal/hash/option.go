@@ -0,0 +1,27 @@
+package hash
+
+// Option customises how Calc and Equal treat the values given to them.
+type Option func(config) config
+
+type config struct {
+	followPointers bool
+}
+
+// FollowPointers makes pointers be hashed and compared by the values they point
+// to, instead of by address. Nil pointers are only equal to other nil pointers.
+func FollowPointers() Option {
+	return func(c config) config {
+		c.followPointers = true
+
+		return c
+	}
+}
+
+func newConfig(options []Option) config {
+	c := config{followPointers: false}
+	for _, option := range options {
+		c = option(c)
+	}
+
+	return c
+}
@@ -0,0 +1,177 @@
+// Package jsonentries encodes and decodes key/value entries, as objects when
+// keys are strings and as arrays of [key, value] pairs otherwise, so that all
+// maps are encoded the same way.
+package jsonentries
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// Encoded holds the encoded key and value of an entry.
+type Encoded struct {
+	Key   []byte
+	Value []byte
+}
+
+// Encode encodes the given key/value pair. String keys are encoded as plain
+// strings, even when their type knows how to encode itself otherwise, as they
+// become object keys.
+func Encode[Key any, Value any](key Key, value Value) (Encoded, error) {
+	var encodedKey []byte
+
+	var err error
+
+	if StringKeys[Key]() {
+		encodedKey, err = json.Marshal(reflect.ValueOf(key).String())
+	} else {
+		encodedKey, err = json.Marshal(key)
+	}
+
+	if err != nil {
+		return Encoded{Key: nil, Value: nil}, err //nolint:wrapcheck // surfacing the error as if encoding the key itself
+	}
+
+	encodedValue, err := json.Marshal(value)
+	if err != nil {
+		return Encoded{Key: nil, Value: nil}, err //nolint:wrapcheck // surfacing the error as if encoding the value itself
+	}
+
+	return Encoded{Key: encodedKey, Value: encodedValue}, nil
+}
+
+// Write writes the given entries, in the given order, as an object when keys
+// are strings, and as an array of [key, value] pairs otherwise.
+func Write[Key any](entries []Encoded) []byte {
+	var buffer bytes.Buffer
+
+	if StringKeys[Key]() {
+		buffer.WriteByte('{')
+
+		for i, entry := range entries {
+			if i > 0 {
+				buffer.WriteByte(',')
+			}
+
+			buffer.Write(entry.Key)
+			buffer.WriteByte(':')
+			buffer.Write(entry.Value)
+		}
+
+		buffer.WriteByte('}')
+
+		return buffer.Bytes()
+	}
+
+	buffer.WriteByte('[')
+
+	for i, entry := range entries {
+		if i > 0 {
+			buffer.WriteByte(',')
+		}
+
+		buffer.WriteByte('[')
+		buffer.Write(entry.Key)
+		buffer.WriteByte(',')
+		buffer.Write(entry.Value)
+		buffer.WriteByte(']')
+	}
+
+	buffer.WriteByte(']')
+
+	return buffer.Bytes()
+}
+
+// Decode decodes an object when keys are strings, and an array of
+// [key, value] pairs otherwise, calling put for each entry in the order they
+// come in. Object members sharing the same name are put only once, with the
+// last value, in the order of the first one. Anything but an array of pairs
+// fails with the given invalid error when keys are not strings.
+func Decode[Key any, Value any](data []byte, invalid error, put func(Key, Value)) error {
+	if StringKeys[Key]() {
+		return decodeObject(data, put)
+	}
+
+	var pairs []json.RawMessage
+	if err := json.Unmarshal(data, &pairs); err != nil {
+		return fmt.Errorf("%w: %s", invalid, err.Error())
+	}
+
+	for _, pair := range pairs {
+		var parts []json.RawMessage
+		if err := json.Unmarshal(pair, &parts); err != nil || len(parts) != 2 { //nolint:mnd // key + value
+			return fmt.Errorf("%w: got %s", invalid, pair)
+		}
+
+		var key Key
+		if err := json.Unmarshal(parts[0], &key); err != nil {
+			return err //nolint:wrapcheck // surfacing the error as if decoding the key itself
+		}
+
+		var value Value
+		if err := json.Unmarshal(parts[1], &value); err != nil {
+			return err //nolint:wrapcheck // surfacing the error as if decoding the value itself
+		}
+
+		put(key, value)
+	}
+
+	return nil
+}
+
+// StringKeys tells whether keys are strings, in which case entries are encoded
+// as objects.
+func StringKeys[Key any]() bool {
+	return reflect.TypeOf((*Key)(nil)).Elem().Kind() == reflect.String
+}
+
+func decodeObject[Key any, Value any](data []byte, put func(Key, Value)) error {
+	var object map[string]Value
+	if err := json.Unmarshal(data, &object); err != nil {
+		return err //nolint:wrapcheck // surfacing the error as if decoding the object itself
+	}
+
+	names, err := objectNames(data)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range names {
+		key := reflect.New(reflect.TypeOf((*Key)(nil)).Elem()).Elem()
+		key.SetString(name)
+		put(key.Interface().(Key), object[name])
+	}
+
+	return nil
+}
+
+// objectNames lists the distinct member names of the given object, in the order
+// they first come in. A null object has no members.
+func objectNames(data []byte) ([]string, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	if token, err := decoder.Token(); err != nil || token == nil {
+		return nil, err //nolint:wrapcheck // the object was decoded successfully before
+	}
+
+	names, seen := make([]string, 0), make(map[string]bool)
+
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, err //nolint:wrapcheck // the object was decoded successfully before
+		}
+
+		var skipped json.RawMessage
+		if err := decoder.Decode(&skipped); err != nil {
+			return nil, err //nolint:wrapcheck // the object was decoded successfully before
+		}
+
+		if name := token.(string); !seen[name] {
+			names, seen[name] = append(names, name), true
+		}
+	}
+
+	return names, nil
+}
@@ -0,0 +1,39 @@
+// Package jsonorder orders encoded JSON values, so that collections without an
+// order of their own can be encoded the same way every time.
+package jsonorder
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+	"strconv"
+)
+
+// Less tells whether the encoded JSON value a comes before b. Numbers are
+// ordered numerically and strings by their decoded contents; any other values,
+// as well as values of different kinds, are ordered by their encoding.
+func Less(a []byte, b []byte) bool {
+	a, b = bytes.TrimSpace(a), bytes.TrimSpace(b)
+
+	if numberA, errA := strconv.ParseFloat(string(a), 64); errA == nil {
+		if numberB, errB := strconv.ParseFloat(string(b), 64); errB == nil && numberA != numberB {
+			return numberA < numberB
+		}
+	}
+
+	if len(a) > 0 && len(b) > 0 && a[0] == '"' && b[0] == '"' {
+		var stringA, stringB string
+		if json.Unmarshal(a, &stringA) == nil && json.Unmarshal(b, &stringB) == nil && stringA != stringB {
+			return stringA < stringB
+		}
+	}
+
+	return bytes.Compare(a, b) < 0
+}
+
+// Sort sorts the given items by their encoded JSON values, as ordered by Less.
+func Sort[Type any](items []Type, encoded func(Type) []byte) {
+	sort.SliceStable(items, func(i, j int) bool {
+		return Less(encoded(items[i]), encoded(items[j]))
+	})
+}
@@ -0,0 +1,54 @@
+package table
+
+// Builder builds a Table by updating it in place. Updates only copy the parts
+// of the trie shared with Tables built before, so building up a Table from
+// scratch copies nothing. The zero value is ready to use.
+type Builder[Key any, Value any] struct {
+	table Table[Key, Value]
+	owner *owner
+}
+
+// NewBuilder creates a Builder starting off with the entries of the given
+// Table, which is left untouched.
+func NewBuilder[Key any, Value any](from Table[Key, Value]) *Builder[Key, Value] {
+	return &Builder[Key, Value]{table: from, owner: nil}
+}
+
+// Size returns the number of entries this Builder holds.
+func (b *Builder[Key, Value]) Size() int {
+	return b.table.Size()
+}
+
+// Get retrieves the Value stored for the given Key. The boolean result reports
+// whether the Key was found.
+func (b *Builder[Key, Value]) Get(key Key) (Value, bool) {
+	return b.table.Get(key)
+}
+
+// Put stores the given key/value pair, replacing the entry whose key is equal
+// to the given Key.
+func (b *Builder[Key, Value]) Put(key Key, value Value) {
+	b.table = b.table.put(key, value, b.currentOwner())
+}
+
+// Remove removes the entry whose key is equal to the given Key.
+func (b *Builder[Key, Value]) Remove(key Key) {
+	b.table = b.table.remove(key, b.currentOwner())
+}
+
+// Freeze returns the Table built so far, without copying it. The Builder can
+// still be used afterwards, but it no longer updates in place the nodes now
+// shared with the returned Table.
+func (b *Builder[Key, Value]) Freeze() Table[Key, Value] {
+	b.owner = nil
+
+	return b.table
+}
+
+func (b *Builder[Key, Value]) currentOwner() *owner {
+	if b.owner == nil {
+		b.owner = &owner{}
+	}
+
+	return b.owner
+}
@@ -0,0 +1,76 @@
+package table
+
+import "github.com/gtramontina/go-extlib/iterator"
+
+// cursor walks through the entries of a trie, lazily. It keeps the path to the
+// next slot on a stack of frames, along with what is left of the bucket it is
+// currently walking through.
+type cursor[Key any, Value any] struct {
+	frames []frame[Key, Value]
+	bucket []Entry[Key, Value]
+}
+
+// frame holds a node along with the position of the next slot to visit.
+type frame[Key any, Value any] struct {
+	node *node[Key, Value]
+	next int
+}
+
+// All returns an iterator.Iterator over all entries held by this Table in no
+// particular order.
+func (t Table[Key, Value]) All() iterator.Iterator[Entry[Key, Value]] {
+	c := &cursor[Key, Value]{frames: nil, bucket: nil}
+	if t.root != nil {
+		c.frames = append(c.frames, frame[Key, Value]{node: t.root, next: 0})
+	}
+
+	return c
+}
+
+// settle moves on to the next non-empty bucket, unless the current one is not
+// exhausted yet.
+func (c *cursor[Key, Value]) settle() {
+	for len(c.bucket) == 0 && len(c.frames) > 0 {
+		top := &c.frames[len(c.frames)-1]
+		if top.next == len(top.node.slots) {
+			c.frames = c.frames[:len(c.frames)-1]
+
+			continue
+		}
+
+		current := top.node.slots[top.next]
+		top.next++
+
+		if current.branch != nil {
+			c.frames = append(c.frames, frame[Key, Value]{node: current.branch, next: 0})
+		} else {
+			c.bucket = current.bucket
+		}
+	}
+}
+
+func (c *cursor[Key, Value]) HasNext() bool {
+	c.settle()
+
+	return len(c.bucket) > 0
+}
+
+func (c *cursor[Key, Value]) Next() Entry[Key, Value] {
+	if !c.HasNext() {
+		panic(iterator.ErrIteratorEmpty)
+	}
+
+	next := c.bucket[0]
+	c.bucket = c.bucket[1:]
+
+	return next
+}
+
+func (c *cursor[Key, Value]) Collect() []Entry[Key, Value] {
+	entries := make([]Entry[Key, Value], 0)
+	for c.HasNext() {
+		entries = append(entries, c.Next())
+	}
+
+	return entries
+}
@@ -0,0 +1,272 @@
+package table
+
+import (
+	"math/bits"
+
+	"github.com/gtramontina/go-extlib/internal/hash"
+)
+
+const (
+	bitsPerLevel = 5
+	levelMask    = 1<<bitsPerLevel - 1
+)
+
+// node is a level of the trie. Its bitmap tells which of the 32 possible
+// branches are in use, and slots holds only those, in ascending order. Nodes
+// belonging to an owner may be updated in place by that owner; all others are
+// copied on update.
+type node[Key any, Value any] struct {
+	bitmap uint32
+	slots  []slot[Key, Value]
+	owner  *owner
+}
+
+// owner identifies the Builder allowed to update its nodes in place. It is not
+// zero-sized, so that each owner has an address of its own.
+type owner struct{ _ byte }
+
+// slot is either a branch pointing to a deeper node or a leaf holding the
+// bucket of entries whose keys share the same hash.
+type slot[Key any, Value any] struct {
+	branch *node[Key, Value]
+	hash   uint64
+	bucket []Entry[Key, Value]
+}
+
+func leaf[Key any, Value any](hashedKey uint64, entry Entry[Key, Value]) slot[Key, Value] {
+	return slot[Key, Value]{branch: nil, hash: hashedKey, bucket: []Entry[Key, Value]{entry}}
+}
+
+func fork[Key any, Value any](branch *node[Key, Value]) slot[Key, Value] {
+	return slot[Key, Value]{branch: branch, hash: 0, bucket: nil}
+}
+
+func bitFor(hashedKey uint64, shift uint) uint32 {
+	return 1 << ((hashedKey >> shift) & levelMask)
+}
+
+func (n *node[Key, Value]) position(bit uint32) int {
+	return bits.OnesCount32(n.bitmap & (bit - 1))
+}
+
+func (n *node[Key, Value]) get(hashedKey uint64, key Key, shift uint, options []hash.Option) *Entry[Key, Value] {
+	bit := bitFor(hashedKey, shift)
+	if n.bitmap&bit == 0 {
+		return nil
+	}
+
+	existing := &n.slots[n.position(bit)]
+	if existing.branch != nil {
+		return existing.branch.get(hashedKey, key, shift+bitsPerLevel, options)
+	}
+
+	if existing.hash != hashedKey {
+		return nil
+	}
+
+	for i := range existing.bucket {
+		if hash.Equal(existing.bucket[i].Key, key, options...) {
+			return &existing.bucket[i]
+		}
+	}
+
+	return nil
+}
+
+func (n *node[Key, Value]) put(
+	hashedKey uint64,
+	entry Entry[Key, Value],
+	shift uint,
+	by *owner,
+	options []hash.Option,
+) (*node[Key, Value], bool) {
+	bit := bitFor(hashedKey, shift)
+	pos := n.position(bit)
+
+	if n.bitmap&bit == 0 {
+		return n.insertSlot(bit, pos, leaf(hashedKey, entry), by), true
+	}
+
+	existing := n.slots[pos]
+
+	switch {
+	case existing.branch != nil:
+		branch, added := existing.branch.put(hashedKey, entry, shift+bitsPerLevel, by, options)
+
+		return n.replaceSlot(pos, fork(branch), by), added
+	case existing.hash == hashedKey:
+		bucket, added := bucketWith(existing.bucket, entry, options)
+
+		return n.replaceSlot(pos, slot[Key, Value]{branch: nil, hash: hashedKey, bucket: bucket}, by), added
+	default:
+		return n.replaceSlot(pos, fork(merge(existing, leaf(hashedKey, entry), shift+bitsPerLevel, by)), by), true
+	}
+}
+
+func (n *node[Key, Value]) remove(
+	hashedKey uint64,
+	key Key,
+	shift uint,
+	by *owner,
+	options []hash.Option,
+) (*node[Key, Value], bool) {
+	bit := bitFor(hashedKey, shift)
+	if n.bitmap&bit == 0 {
+		return n, false
+	}
+
+	pos := n.position(bit)
+	existing := n.slots[pos]
+
+	if existing.branch != nil {
+		branch, removed := existing.branch.remove(hashedKey, key, shift+bitsPerLevel, by, options)
+		if !removed {
+			return n, false
+		}
+
+		// A branch left with a single leaf is pulled up, keeping the trie as
+		// shallow as the remaining keys allow.
+		if len(branch.slots) == 1 && branch.slots[0].branch == nil {
+			return n.replaceSlot(pos, branch.slots[0], by), true
+		}
+
+		return n.replaceSlot(pos, fork(branch), by), true
+	}
+
+	if existing.hash != hashedKey {
+		return n, false
+	}
+
+	bucket, removed := bucketWithout(existing.bucket, key, options)
+	if !removed {
+		return n, false
+	}
+
+	if len(bucket) == 0 {
+		return n.removeSlot(bit, pos, by), true
+	}
+
+	return n.replaceSlot(pos, slot[Key, Value]{branch: nil, hash: hashedKey, bucket: bucket}, by), true
+}
+
+func (n *node[Key, Value]) collect(entries []Entry[Key, Value]) []Entry[Key, Value] {
+	for _, existing := range n.slots {
+		if existing.branch != nil {
+			entries = existing.branch.collect(entries)
+		} else {
+			entries = append(entries, existing.bucket...)
+		}
+	}
+
+	return entries
+}
+
+func (n *node[Key, Value]) insertSlot(bit uint32, pos int, newSlot slot[Key, Value], by *owner) *node[Key, Value] {
+	if n.ownedBy(by) {
+		n.slots = append(n.slots, slot[Key, Value]{branch: nil, hash: 0, bucket: nil})
+		copy(n.slots[pos+1:], n.slots[pos:])
+		n.slots[pos] = newSlot
+		n.bitmap |= bit
+
+		return n
+	}
+
+	slots := make([]slot[Key, Value], len(n.slots)+1)
+	copy(slots, n.slots[:pos])
+	slots[pos] = newSlot
+	copy(slots[pos+1:], n.slots[pos:])
+
+	return &node[Key, Value]{bitmap: n.bitmap | bit, slots: slots, owner: by}
+}
+
+func (n *node[Key, Value]) replaceSlot(pos int, newSlot slot[Key, Value], by *owner) *node[Key, Value] {
+	if n.ownedBy(by) {
+		n.slots[pos] = newSlot
+
+		return n
+	}
+
+	slots := make([]slot[Key, Value], len(n.slots))
+	copy(slots, n.slots)
+	slots[pos] = newSlot
+
+	return &node[Key, Value]{bitmap: n.bitmap, slots: slots, owner: by}
+}
+
+func (n *node[Key, Value]) removeSlot(bit uint32, pos int, by *owner) *node[Key, Value] {
+	if n.ownedBy(by) {
+		copy(n.slots[pos:], n.slots[pos+1:])
+		n.slots[len(n.slots)-1] = slot[Key, Value]{branch: nil, hash: 0, bucket: nil}
+		n.slots = n.slots[:len(n.slots)-1]
+		n.bitmap &^= bit
+
+		return n
+	}
+
+	slots := make([]slot[Key, Value], 0, len(n.slots)-1)
+	slots = append(slots, n.slots[:pos]...)
+	slots = append(slots, n.slots[pos+1:]...)
+
+	return &node[Key, Value]{bitmap: n.bitmap &^ bit, slots: slots, owner: by}
+}
+
+// ownedBy tells whether this node may be updated in place by the given owner.
+// Nodes without an owner are never updated in place.
+func (n *node[Key, Value]) ownedBy(by *owner) bool {
+	return by != nil && n.owner == by
+}
+
+// merge builds the branch holding two leaves whose hashes differ. Both leaves
+// share the path leading to the branch, so they sit under a chain of single
+// branches until their hashes diverge.
+func merge[Key any, Value any](leafA slot[Key, Value], leafB slot[Key, Value], shift uint, by *owner) *node[Key, Value] {
+	bitA, bitB := bitFor(leafA.hash, shift), bitFor(leafB.hash, shift)
+
+	if bitA == bitB {
+		branch := fork(merge(leafA, leafB, shift+bitsPerLevel, by))
+
+		return &node[Key, Value]{bitmap: bitA, slots: []slot[Key, Value]{branch}, owner: by}
+	}
+
+	if bitA > bitB {
+		leafA, leafB = leafB, leafA
+	}
+
+	return &node[Key, Value]{bitmap: bitA | bitB, slots: []slot[Key, Value]{leafA, leafB}, owner: by}
+}
+
+func bucketWith[Key any, Value any](
+	bucket []Entry[Key, Value],
+	entry Entry[Key, Value],
+	options []hash.Option,
+) ([]Entry[Key, Value], bool) {
+	newBucket := make([]Entry[Key, Value], len(bucket), len(bucket)+1)
+	copy(newBucket, bucket)
+
+	for i, existing := range bucket {
+		if hash.Equal(existing.Key, entry.Key, options...) {
+			newBucket[i] = entry
+
+			return newBucket, false
+		}
+	}
+
+	return append(newBucket, entry), true
+}
+
+func bucketWithout[Key any, Value any](
+	bucket []Entry[Key, Value],
+	key Key,
+	options []hash.Option,
+) ([]Entry[Key, Value], bool) {
+	for i, existing := range bucket {
+		if hash.Equal(existing.Key, key, options...) {
+			newBucket := make([]Entry[Key, Value], 0, len(bucket)-1)
+			newBucket = append(newBucket, bucket[:i]...)
+
+			return append(newBucket, bucket[i+1:]...), true
+		}
+	}
+
+	return bucket, false
+}
@@ -0,0 +1,119 @@
+package table
+
+import "github.com/gtramontina/go-extlib/internal/hash"
+
+// Table is an immutable hash table backed by a hash array mapped trie (HAMT).
+// Each level of the trie consumes a few bits of the hash of a key (hash.Calc)
+// to pick a branch, so updates copy only the path from the root down to the
+// affected entry and share everything else with the original Table. Keys whose
+// hashes fully collide live side by side in the same bucket and are told apart
+// by hash.Equal, so colliding keys never overwrite each other. Tables remember
+// the hash options they were created with, and hash and compare keys with them.
+type Table[Key any, Value any] struct {
+	root    *node[Key, Value]
+	size    int
+	options []hash.Option
+}
+
+// Entry holds a key/value pair stored in a Table.
+type Entry[Key any, Value any] struct {
+	Key   Key
+	Value Value
+}
+
+// New creates a Table containing the given entries. When two entries hold
+// equal keys, the last one wins.
+func New[Key any, Value any](entries ...Entry[Key, Value]) Table[Key, Value] {
+	var builder Builder[Key, Value]
+	for _, entry := range entries {
+		builder.Put(entry.Key, entry.Value)
+	}
+
+	return builder.Freeze()
+}
+
+// With creates an empty Table hashing and comparing keys with the given options.
+// Tables derived from it keep those options.
+func With[Key any, Value any](options ...hash.Option) Table[Key, Value] {
+	return Table[Key, Value]{root: nil, size: 0, options: options}
+}
+
+// Options returns the hash options this Table hashes and compares keys with.
+func (t Table[Key, Value]) Options() []hash.Option {
+	return t.options
+}
+
+// Size returns the number of entries this Table holds.
+func (t Table[Key, Value]) Size() int {
+	return t.size
+}
+
+// Get retrieves the Value stored for the given Key. The boolean result reports
+// whether the Key was found.
+func (t Table[Key, Value]) Get(key Key) (Value, bool) {
+	if t.root != nil {
+		if entry := t.root.get(hash.Calc(key, t.options...), key, 0, t.options); entry != nil {
+			return entry.Value, true
+		}
+	}
+
+	var zero Value
+
+	return zero, false
+}
+
+// Put creates a Table containing all entries of this Table plus the given
+// key/value pair, replacing the entry whose key is equal to the given Key.
+func (t Table[Key, Value]) Put(key Key, value Value) Table[Key, Value] {
+	return t.put(key, value, nil)
+}
+
+// Remove creates a Table containing all entries of this Table but the one whose
+// key is equal to the given Key.
+func (t Table[Key, Value]) Remove(key Key) Table[Key, Value] {
+	return t.remove(key, nil)
+}
+
+// Entries returns all entries held by this Table in no particular order.
+func (t Table[Key, Value]) Entries() []Entry[Key, Value] {
+	entries := make([]Entry[Key, Value], 0, t.size)
+	if t.root != nil {
+		entries = t.root.collect(entries)
+	}
+
+	return entries
+}
+
+// put and remove update the trie on behalf of the given owner, updating in
+// place the nodes it owns and copying all others. Without an owner, the trie
+// is left untouched.
+func (t Table[Key, Value]) put(key Key, value Value, by *owner) Table[Key, Value] {
+	root := t.root
+	if root == nil {
+		root = &node[Key, Value]{bitmap: 0, slots: nil, owner: by}
+	}
+
+	newRoot, added := root.put(hash.Calc(key, t.options...), Entry[Key, Value]{key, value}, 0, by, t.options)
+	if added {
+		return Table[Key, Value]{root: newRoot, size: t.size + 1, options: t.options}
+	}
+
+	return Table[Key, Value]{root: newRoot, size: t.size, options: t.options}
+}
+
+func (t Table[Key, Value]) remove(key Key, by *owner) Table[Key, Value] {
+	if t.root == nil {
+		return t
+	}
+
+	newRoot, removed := t.root.remove(hash.Calc(key, t.options...), key, 0, by, t.options)
+	if !removed {
+		return t
+	}
+
+	if len(newRoot.slots) == 0 {
+		newRoot = nil
+	}
+
+	return Table[Key, Value]{root: newRoot, size: t.size - 1, options: t.options}
+}
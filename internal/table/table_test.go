@@ -0,0 +1,267 @@
+package table_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gtramontina/go-extlib/internal/hash"
+	"github.com/gtramontina/go-extlib/internal/table"
+	"github.com/gtramontina/go-extlib/iterator"
+	"github.com/gtramontina/go-extlib/testing/assert"
+)
+
+// collidingKey hashes all of its values to the same hash code.
+type collidingKey string
+
+func (collidingKey) Hash() uint64 { return 0 }
+
+func assertFound[Key any](t *testing.T, subject table.Table[Key, int], key Key, expected int) {
+	t.Helper()
+
+	value, found := subject.Get(key)
+	assert.True(t, found)
+	assert.Eq(t, value, expected)
+}
+
+func TestTable(t *testing.T) {
+	entry := func(key float64, value string) table.Entry[float64, string] {
+		return table.Entry[float64, string]{Key: key, Value: value}
+	}
+
+	get := func(t table.Table[float64, string], key float64) string {
+		value, _ := t.Get(key)
+
+		return value
+	}
+
+	t.Run("is empty when created without entries", func(t *testing.T) {
+		empty := table.New[float64, string]()
+		assert.Eq(t, empty.Size(), 0)
+		assert.DeepEqual(t, empty.Entries(), []table.Entry[float64, string]{})
+
+		_, found := empty.Get(0)
+		assert.False(t, found)
+	})
+
+	t.Run("keeps the last entry when keys are equal", func(t *testing.T) {
+		filled := table.New(entry(1, "a"), entry(2, "b"), entry(1, "c"))
+		assert.Eq(t, filled.Size(), 2)
+		assert.Eq(t, get(filled, 1), "c")
+		assert.Eq(t, get(filled, 2), "b")
+	})
+
+	t.Run("puts entries without mutating the table", func(t *testing.T) {
+		empty := table.New[float64, string]()
+		one := empty.Put(1, "a")
+		two := one.Put(2, "b")
+		replaced := two.Put(1, "c")
+
+		assert.Eq(t, empty.Size(), 0)
+		assert.Eq(t, one.Size(), 1)
+		assert.Eq(t, two.Size(), 2)
+		assert.Eq(t, replaced.Size(), 2)
+		assert.Eq(t, get(two, 1), "a")
+		assert.Eq(t, get(replaced, 1), "c")
+	})
+
+	t.Run("removes entries without mutating the table", func(t *testing.T) {
+		two := table.New(entry(1, "a"), entry(2, "b"))
+		one := two.Remove(1)
+		unchanged := one.Remove(3)
+
+		assert.Eq(t, two.Size(), 2)
+		assert.Eq(t, one.Size(), 1)
+		assert.Eq(t, unchanged.Size(), 1)
+		assert.Eq(t, get(two, 1), "a")
+
+		_, found := one.Get(1)
+		assert.False(t, found)
+	})
+
+	t.Run("keeps colliding keys apart", func(t *testing.T) {
+		keyA, keyB := collidingKey("a"), collidingKey("b")
+		assert.Eq(t, hash.Calc(keyA), hash.Calc(keyB))
+
+		colliding := table.New(table.Entry[collidingKey, int]{Key: keyA, Value: 1}).Put(keyB, 2)
+		assert.Eq(t, colliding.Size(), 2)
+		assertFound(t, colliding, keyA, 1)
+		assertFound(t, colliding, keyB, 2)
+
+		replaced := colliding.Put(keyB, 3)
+		assertFound(t, replaced, keyA, 1)
+		assertFound(t, replaced, keyB, 3)
+		assertFound(t, colliding, keyB, 2)
+
+		removed := colliding.Remove(keyA)
+		assert.Eq(t, removed.Size(), 1)
+		assertFound(t, removed, keyB, 2)
+		assertFound(t, colliding, keyA, 1)
+		assert.DeepEqual(t, removed.Remove(keyB), table.New[collidingKey, int]())
+	})
+
+	t.Run("handles many entries", func(t *testing.T) {
+		const size = 10_000
+
+		filled := table.New[float64, string]()
+		for i := 0; i < size; i++ {
+			filled = filled.Put(float64(i), fmt.Sprint(i))
+		}
+
+		assert.Eq(t, filled.Size(), size)
+		assert.Eq(t, len(filled.Entries()), size)
+
+		for i := 0; i < size; i++ {
+			assert.Eq(t, get(filled, float64(i)), fmt.Sprint(i))
+		}
+
+		halved := filled
+		for i := 0; i < size; i += 2 {
+			halved = halved.Remove(float64(i))
+		}
+
+		assert.Eq(t, halved.Size(), size/2)
+		assert.Eq(t, filled.Size(), size)
+
+		for i := 0; i < size; i++ {
+			_, found := halved.Get(float64(i))
+			assert.Eq(t, found, i%2 == 1)
+		}
+	})
+
+	t.Run("iterates over all entries", func(t *testing.T) {
+		const size = 1_000
+
+		filled := table.New[collidingKey, int]().Put("a", -1).Put("b", -2)
+		for i := 0; i < size; i++ {
+			filled = filled.Put(collidingKey(fmt.Sprint(i)), i)
+		}
+
+		seen := map[collidingKey]int{}
+		iter := filled.All()
+
+		for iter.HasNext() {
+			entry := iter.Next()
+			seen[entry.Key] = entry.Value
+		}
+
+		assert.Eq(t, len(seen), size+2)
+		assert.Eq(t, seen["b"], -2)
+		assert.Eq(t, seen["999"], 999)
+		assert.PanicsWith(t, func() { iter.Next() }, iterator.ErrIteratorEmpty)
+		assert.DeepEqual(t, table.New[float64, string]().All().Collect(), []table.Entry[float64, string]{})
+		assert.Eq(t, len(table.New(entry(1, "a"), entry(2, "b")).All().Collect()), 2)
+	})
+
+	t.Run("hashes and compares keys with the options it was created with", func(t *testing.T) {
+		valueA, valueB, valueC := 1, 1, 1
+
+		byAddress := table.New[*int, int]().Put(&valueA, 1).Put(&valueB, 2)
+		assert.Eq(t, byAddress.Size(), 2)
+
+		byPointee := table.With[*int, int](hash.FollowPointers()).Put(&valueA, 1).Put(&valueB, 2)
+		assert.Eq(t, byPointee.Size(), 1)
+		assertFound(t, byPointee, &valueA, 2)
+		assert.Eq(t, byPointee.Remove(&valueB).Size(), 0)
+
+		builder := table.NewBuilder(byPointee)
+		builder.Put(&valueC, 3)
+		assert.Eq(t, builder.Size(), 1)
+		assertFound(t, builder.Freeze(), &valueB, 3)
+	})
+
+	t.Run("has the same structure regardless of the history of updates", func(t *testing.T) {
+		const size = 1_000
+
+		direct := table.New[float64, string]()
+		for i := 0; i < size; i += 2 {
+			direct = direct.Put(float64(i), "")
+		}
+
+		indirect := table.New[float64, string]()
+		for i := size - 1; i >= 0; i-- {
+			indirect = indirect.Put(float64(i), "")
+		}
+
+		for i := 1; i < size; i += 2 {
+			indirect = indirect.Remove(float64(i))
+		}
+
+		assert.DeepEqual(t, indirect, direct)
+
+		for i := 0; i < size; i += 2 {
+			indirect = indirect.Remove(float64(i))
+		}
+
+		assert.DeepEqual(t, indirect, table.New[float64, string]())
+	})
+}
+
+func TestBuilder(t *testing.T) {
+	t.Run("is empty when zero", func(t *testing.T) {
+		var builder table.Builder[float64, int]
+		assert.Eq(t, builder.Size(), 0)
+		assert.Eq(t, builder.Freeze().Size(), 0)
+	})
+
+	t.Run("builds the same table as putting and removing one by one", func(t *testing.T) {
+		const size = 1_000
+
+		var builder table.Builder[float64, int]
+
+		persistent := table.New[float64, int]()
+		for i := 0; i < size; i++ {
+			builder.Put(float64(i), i)
+			persistent = persistent.Put(float64(i), i)
+		}
+
+		for i := 0; i < size; i += 3 {
+			builder.Remove(float64(i))
+			persistent = persistent.Remove(float64(i))
+		}
+
+		built := builder.Freeze()
+		assert.Eq(t, built.Size(), persistent.Size())
+
+		for i := 0; i < size; i++ {
+			_, found := built.Get(float64(i))
+			assert.Eq(t, found, i%3 != 0)
+
+			if found {
+				assertFound(t, built, float64(i), i)
+			}
+		}
+	})
+
+	t.Run("keeps frozen tables untouched", func(t *testing.T) {
+		var builder table.Builder[collidingKey, int]
+
+		builder.Put("a", 1)
+		builder.Put("b", 2)
+		frozen := builder.Freeze()
+
+		builder.Put("a", 3)
+		builder.Remove("b")
+		builder.Put("c", 4)
+		refrozen := builder.Freeze()
+
+		assert.Eq(t, frozen.Size(), 2)
+		assertFound(t, frozen, "a", 1)
+		assertFound(t, frozen, "b", 2)
+		assert.Eq(t, refrozen.Size(), 2)
+		assertFound(t, refrozen, "a", 3)
+		assertFound(t, refrozen, "c", 4)
+	})
+
+	t.Run("starts off with the entries of a table, leaving it untouched", func(t *testing.T) {
+		original := table.New(table.Entry[float64, int]{Key: 1, Value: 1})
+
+		builder := table.NewBuilder(original)
+		builder.Put(1, 2)
+		builder.Put(2, 2)
+
+		assert.Eq(t, original.Size(), 1)
+		assertFound(t, original, 1.0, 1)
+		assertFound(t, builder.Freeze(), 1.0, 2)
+		assertFound(t, builder.Freeze(), 2.0, 2)
+	})
+}
@@ -0,0 +1,74 @@
+package tree
+
+import "github.com/gtramontina/go-extlib/iterator"
+
+// cursor walks through the entries of a tree in order, lazily. It keeps the
+// path to the next entry on a stack, holding the nodes whose left subtrees
+// have been walked through but not themselves.
+type cursor[Key any, Value any] struct {
+	stack   []*node[Key, Value]
+	less    func(Key, Key) bool
+	bounded bool
+	until   Key
+}
+
+// All returns an iterator.Iterator over all entries of this Tree, ordered by
+// key.
+func (t Tree[Key, Value]) All() iterator.Iterator[Entry[Key, Value]] {
+	c := &cursor[Key, Value]{stack: nil, less: t.less, bounded: false, until: *new(Key)}
+	c.pushLeftmost(t.root)
+
+	return c
+}
+
+// Range returns an iterator.Iterator over the entries of this Tree whose keys
+// are greater than or equal to from and less than until, ordered by key.
+func (t Tree[Key, Value]) Range(from Key, until Key) iterator.Iterator[Entry[Key, Value]] {
+	c := &cursor[Key, Value]{stack: nil, less: t.less, bounded: true, until: until}
+
+	for n := t.root; n != nil; {
+		if t.less(n.key, from) {
+			n = n.right
+		} else {
+			c.stack = append(c.stack, n)
+			n = n.left
+		}
+	}
+
+	return c
+}
+
+func (c *cursor[Key, Value]) pushLeftmost(n *node[Key, Value]) {
+	for ; n != nil; n = n.left {
+		c.stack = append(c.stack, n)
+	}
+}
+
+func (c *cursor[Key, Value]) HasNext() bool {
+	if len(c.stack) == 0 {
+		return false
+	}
+
+	return !c.bounded || c.less(c.stack[len(c.stack)-1].key, c.until)
+}
+
+func (c *cursor[Key, Value]) Next() Entry[Key, Value] {
+	if !c.HasNext() {
+		panic(iterator.ErrIteratorEmpty)
+	}
+
+	next := c.stack[len(c.stack)-1]
+	c.stack = c.stack[:len(c.stack)-1]
+	c.pushLeftmost(next.right)
+
+	return Entry[Key, Value]{next.key, next.value}
+}
+
+func (c *cursor[Key, Value]) Collect() []Entry[Key, Value] {
+	entries := make([]Entry[Key, Value], 0, len(c.stack))
+	for c.HasNext() {
+		entries = append(entries, c.Next())
+	}
+
+	return entries
+}
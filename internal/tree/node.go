@@ -0,0 +1,115 @@
+package tree
+
+// node is a node of an AVL tree: the heights of the subtrees of any node differ
+// by one at most. Nodes are never updated; updates copy the path from the root
+// down to the affected node and share everything else.
+type node[Key any, Value any] struct {
+	key    Key
+	value  Value
+	left   *node[Key, Value]
+	right  *node[Key, Value]
+	height int
+}
+
+func heightOf[Key any, Value any](n *node[Key, Value]) int {
+	if n == nil {
+		return 0
+	}
+
+	return n.height
+}
+
+func build[Key any, Value any](key Key, value Value, left *node[Key, Value], right *node[Key, Value]) *node[Key, Value] {
+	height := heightOf(left)
+	if heightOf(right) > height {
+		height = heightOf(right)
+	}
+
+	return &node[Key, Value]{key: key, value: value, left: left, right: right, height: height + 1}
+}
+
+// balance builds a node out of the given parts, rotating them when the heights
+// of the subtrees differ by two.
+func balance[Key any, Value any](key Key, value Value, left *node[Key, Value], right *node[Key, Value]) *node[Key, Value] {
+	switch {
+	case heightOf(left) > heightOf(right)+1:
+		if heightOf(left.left) >= heightOf(left.right) {
+			return build(left.key, left.value, left.left, build(key, value, left.right, right))
+		}
+
+		pivot := left.right
+
+		return build(pivot.key, pivot.value, build(left.key, left.value, left.left, pivot.left), build(key, value, pivot.right, right))
+	case heightOf(right) > heightOf(left)+1:
+		if heightOf(right.right) >= heightOf(right.left) {
+			return build(right.key, right.value, build(key, value, left, right.left), right.right)
+		}
+
+		pivot := right.left
+
+		return build(pivot.key, pivot.value, build(key, value, left, pivot.left), build(right.key, right.value, pivot.right, right.right))
+	default:
+		return build(key, value, left, right)
+	}
+}
+
+func (t Tree[Key, Value]) put(n *node[Key, Value], key Key, value Value) (*node[Key, Value], bool) {
+	if n == nil {
+		return build[Key, Value](key, value, nil, nil), true
+	}
+
+	switch {
+	case t.less(key, n.key):
+		left, added := t.put(n.left, key, value)
+
+		return balance(n.key, n.value, left, n.right), added
+	case t.less(n.key, key):
+		right, added := t.put(n.right, key, value)
+
+		return balance(n.key, n.value, n.left, right), added
+	default:
+		return build(key, value, n.left, n.right), false
+	}
+}
+
+func (t Tree[Key, Value]) remove(n *node[Key, Value], key Key) (*node[Key, Value], bool) {
+	if n == nil {
+		return nil, false
+	}
+
+	switch {
+	case t.less(key, n.key):
+		left, removed := t.remove(n.left, key)
+		if !removed {
+			return n, false
+		}
+
+		return balance(n.key, n.value, left, n.right), true
+	case t.less(n.key, key):
+		right, removed := t.remove(n.right, key)
+		if !removed {
+			return n, false
+		}
+
+		return balance(n.key, n.value, n.left, right), true
+	case n.left == nil:
+		return n.right, true
+	case n.right == nil:
+		return n.left, true
+	default:
+		successor := n.right
+		for successor.left != nil {
+			successor = successor.left
+		}
+
+		return balance(successor.key, successor.value, n.left, removeFirst(n.right)), true
+	}
+}
+
+func removeFirst[Key any, Value any](n *node[Key, Value]) *node[Key, Value] {
+	if n.left == nil {
+		return n.right
+	}
+
+	return balance(n.key, n.value, removeFirst(n.left), n.right)
+}
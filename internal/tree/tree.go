@@ -0,0 +1,190 @@
+package tree
+
+import (
+	"reflect"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Tree is an immutable sorted map backed by a persistent AVL tree. Keys are
+// ordered by the given less function, and two keys are deemed equal when
+// neither is less than the other. Updates copy only the path from the root
+// down to the affected entry and share everything else with the original Tree.
+type Tree[Key any, Value any] struct {
+	root *node[Key, Value]
+	size int
+	less func(Key, Key) bool
+}
+
+// Entry holds a key/value pair stored in a Tree.
+type Entry[Key any, Value any] struct {
+	Key   Key
+	Value Value
+}
+
+// New creates an empty Tree ordering its keys by the given less function,
+// which must return whether the first argument comes before the second.
+func New[Key any, Value any](less func(Key, Key) bool) Tree[Key, Value] {
+	return Tree[Key, Value]{root: nil, size: 0, less: less}
+}
+
+// Ascending orders values by their natural order. NaNs come before all other
+// values and are deemed equal to each other, keeping trees of floats sound.
+func Ascending[Key constraints.Ordered](a Key, b Key) bool {
+	return a < b || (a != a && b == b) //nolint:gocritic // a != a only holds for NaNs
+}
+
+// Size returns the number of entries this Tree holds.
+func (t Tree[Key, Value]) Size() int {
+	return t.size
+}
+
+// Less tells whether the first key comes before the second in this Tree.
+func (t Tree[Key, Value]) Less(a Key, b Key) bool {
+	return t.less(a, b)
+}
+
+// Order returns the less function this Tree orders its keys by. The zero Tree
+// has none, in which case keys of ordered kinds, like integers, floats and
+// strings, are ordered naturally, as Ascending does. The boolean result reports
+// whether an order was found.
+func (t Tree[Key, Value]) Order() (func(Key, Key) bool, bool) {
+	if t.less != nil {
+		return t.less, true
+	}
+
+	return natural[Key]()
+}
+
+// Get retrieves the Value stored for the given Key. The boolean result reports
+// whether the Key was found.
+func (t Tree[Key, Value]) Get(key Key) (Value, bool) {
+	for n := t.root; n != nil; {
+		switch {
+		case t.less(key, n.key):
+			n = n.left
+		case t.less(n.key, key):
+			n = n.right
+		default:
+			return n.value, true
+		}
+	}
+
+	var zero Value
+
+	return zero, false
+}
+
+// Put creates a Tree containing all entries of this Tree plus the given
+// key/value pair, replacing the entry whose key is equal to the given Key.
+func (t Tree[Key, Value]) Put(key Key, value Value) Tree[Key, Value] {
+	root, added := t.put(t.root, key, value)
+	if added {
+		return Tree[Key, Value]{root: root, size: t.size + 1, less: t.less}
+	}
+
+	return Tree[Key, Value]{root: root, size: t.size, less: t.less}
+}
+
+// Remove creates a Tree containing all entries of this Tree but the one whose
+// key is equal to the given Key.
+func (t Tree[Key, Value]) Remove(key Key) Tree[Key, Value] {
+	root, removed := t.remove(t.root, key)
+	if !removed {
+		return t
+	}
+
+	return Tree[Key, Value]{root: root, size: t.size - 1, less: t.less}
+}
+
+// First returns the entry holding the least key. The boolean result reports
+// whether this Tree has any entries.
+func (t Tree[Key, Value]) First() (Entry[Key, Value], bool) {
+	if t.root == nil {
+		return Entry[Key, Value]{}, false
+	}
+
+	n := t.root
+	for n.left != nil {
+		n = n.left
+	}
+
+	return Entry[Key, Value]{n.key, n.value}, true
+}
+
+// Last returns the entry holding the greatest key. The boolean result reports
+// whether this Tree has any entries.
+func (t Tree[Key, Value]) Last() (Entry[Key, Value], bool) {
+	if t.root == nil {
+		return Entry[Key, Value]{}, false
+	}
+
+	n := t.root
+	for n.right != nil {
+		n = n.right
+	}
+
+	return Entry[Key, Value]{n.key, n.value}, true
+}
+
+// Floor returns the entry holding the greatest key less than or equal to the
+// given Key. The boolean result reports whether there is such an entry.
+func (t Tree[Key, Value]) Floor(key Key) (Entry[Key, Value], bool) {
+	var found *node[Key, Value]
+
+	for n := t.root; n != nil; {
+		if t.less(key, n.key) {
+			n = n.left
+		} else {
+			found, n = n, n.right
+		}
+	}
+
+	if found == nil {
+		return Entry[Key, Value]{}, false
+	}
+
+	return Entry[Key, Value]{found.key, found.value}, true
+}
+
+// Ceiling returns the entry holding the least key greater than or equal to the
+// given Key. The boolean result reports whether there is such an entry.
+func (t Tree[Key, Value]) Ceiling(key Key) (Entry[Key, Value], bool) {
+	var found *node[Key, Value]
+
+	for n := t.root; n != nil; {
+		if t.less(n.key, key) {
+			n = n.right
+		} else {
+			found, n = n, n.left
+		}
+	}
+
+	if found == nil {
+		return Entry[Key, Value]{}, false
+	}
+
+	return Entry[Key, Value]{found.key, found.value}, true
+}
+
+// Entries returns all entries held by this Tree, ordered by key.
+func (t Tree[Key, Value]) Entries() []Entry[Key, Value] {
+	return t.All().Collect()
+}
+
+// natural returns a less function ordering keys of ordered kinds naturally,
+// even when their type does not satisfy constraints.Ordered.
+func natural[Key any]() (func(Key, Key) bool, bool) {
+	switch reflect.TypeOf((*Key)(nil)).Elem().Kind() { //nolint:exhaustive // only ordered kinds have a natural order
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return func(a Key, b Key) bool { return reflect.ValueOf(a).Int() < reflect.ValueOf(b).Int() }, true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return func(a Key, b Key) bool { return reflect.ValueOf(a).Uint() < reflect.ValueOf(b).Uint() }, true
+	case reflect.Float32, reflect.Float64:
+		return func(a Key, b Key) bool { return Ascending(reflect.ValueOf(a).Float(), reflect.ValueOf(b).Float()) }, true
+	case reflect.String:
+		return func(a Key, b Key) bool { return reflect.ValueOf(a).String() < reflect.ValueOf(b).String() }, true
+	default:
+		return nil, false
+	}
+}
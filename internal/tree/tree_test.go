@@ -0,0 +1,177 @@
+package tree_test
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/gtramontina/go-extlib/internal/tree"
+	"github.com/gtramontina/go-extlib/iterator"
+	"github.com/gtramontina/go-extlib/testing/assert"
+)
+
+func keys(entries iterator.Iterator[tree.Entry[int, string]]) []int {
+	collected := make([]int, 0)
+	for _, entry := range entries.Collect() {
+		collected = append(collected, entry.Key)
+	}
+
+	return collected
+}
+
+func TestTree(t *testing.T) {
+	empty := tree.New[int, string](tree.Ascending[int])
+
+	t.Run("is empty when created", func(t *testing.T) {
+		assert.Eq(t, empty.Size(), 0)
+		assert.DeepEqual(t, empty.Entries(), []tree.Entry[int, string]{})
+
+		_, found := empty.Get(0)
+		assert.False(t, found)
+
+		_, found = empty.First()
+		assert.False(t, found)
+
+		_, found = empty.Last()
+		assert.False(t, found)
+	})
+
+	t.Run("puts and removes entries without mutating the tree", func(t *testing.T) {
+		one := empty.Put(1, "a")
+		two := one.Put(2, "b")
+		replaced := two.Put(1, "c")
+		removed := replaced.Remove(2)
+
+		assert.Eq(t, one.Size(), 1)
+		assert.Eq(t, two.Size(), 2)
+		assert.Eq(t, replaced.Size(), 2)
+		assert.Eq(t, removed.Size(), 1)
+		assert.Eq(t, removed.Remove(3).Size(), 1)
+		assert.DeepEqual(t, two.Entries(), []tree.Entry[int, string]{{1, "a"}, {2, "b"}})
+		assert.DeepEqual(t, replaced.Entries(), []tree.Entry[int, string]{{1, "c"}, {2, "b"}})
+		assert.DeepEqual(t, removed.Entries(), []tree.Entry[int, string]{{1, "c"}})
+	})
+
+	t.Run("keeps entries sorted regardless of the history of updates", func(t *testing.T) {
+		const size = 1_000
+
+		random := rand.New(rand.NewSource(0)) //nolint:gosec // deterministic on purpose
+		filled := empty
+		expected := map[int]bool{}
+
+		for i := 0; i < size*4; i++ {
+			key := random.Intn(size)
+			if random.Intn(3) == 0 {
+				filled = filled.Remove(key)
+				delete(expected, key)
+			} else {
+				filled = filled.Put(key, "")
+				expected[key] = true
+			}
+		}
+
+		sorted := make([]int, 0, len(expected))
+		for key := range expected {
+			sorted = append(sorted, key)
+		}
+
+		sort.Ints(sorted)
+
+		assert.Eq(t, filled.Size(), len(sorted))
+		assert.DeepEqual(t, keys(filled.All()), sorted)
+	})
+
+	t.Run("finds the first, last, floor and ceiling entries", func(t *testing.T) {
+		filled := empty.Put(10, "").Put(30, "").Put(20, "")
+
+		first, _ := filled.First()
+		last, _ := filled.Last()
+		assert.Eq(t, first.Key, 10)
+		assert.Eq(t, last.Key, 30)
+
+		floor, found := filled.Floor(25)
+		assert.True(t, found)
+		assert.Eq(t, floor.Key, 20)
+
+		floor, _ = filled.Floor(20)
+		assert.Eq(t, floor.Key, 20)
+
+		_, found = filled.Floor(5)
+		assert.False(t, found)
+
+		ceiling, found := filled.Ceiling(25)
+		assert.True(t, found)
+		assert.Eq(t, ceiling.Key, 30)
+
+		ceiling, _ = filled.Ceiling(20)
+		assert.Eq(t, ceiling.Key, 20)
+
+		_, found = filled.Ceiling(35)
+		assert.False(t, found)
+	})
+
+	t.Run("iterates over ranges of keys", func(t *testing.T) {
+		filled := empty
+		for i := 0; i < 100; i += 10 {
+			filled = filled.Put(i, "")
+		}
+
+		assert.DeepEqual(t, keys(filled.Range(20, 50)), []int{20, 30, 40})
+		assert.DeepEqual(t, keys(filled.Range(15, 45)), []int{20, 30, 40})
+		assert.DeepEqual(t, keys(filled.Range(-10, 15)), []int{0, 10})
+		assert.DeepEqual(t, keys(filled.Range(85, 200)), []int{90})
+		assert.DeepEqual(t, keys(filled.Range(50, 50)), []int{})
+		assert.DeepEqual(t, keys(filled.Range(50, 20)), []int{})
+
+		iter := filled.Range(90, 100)
+		assert.Eq(t, iter.Next().Key, 90)
+		assert.False(t, iter.HasNext())
+		assert.PanicsWith(t, func() { iter.Next() }, iterator.ErrIteratorEmpty)
+	})
+
+	t.Run("orders by the given less function", func(t *testing.T) {
+		descending := tree.New[int, string](func(a, b int) bool { return a > b }).Put(1, "").Put(3, "").Put(2, "")
+		assert.DeepEqual(t, keys(descending.All()), []int{3, 2, 1})
+	})
+
+	t.Run("orders NaNs first", func(t *testing.T) {
+		floats := tree.New[float64, string](tree.Ascending[float64]).Put(1, "").Put(math.NaN(), "").Put(math.NaN(), "")
+		assert.Eq(t, floats.Size(), 2)
+
+		first, _ := floats.First()
+		assert.True(t, math.IsNaN(first.Key))
+
+		_, found := floats.Get(math.NaN())
+		assert.True(t, found)
+	})
+	t.Run("tells the order of its keys, falling back to their natural order", func(t *testing.T) {
+		type name string
+
+		descending, found := tree.New[int, string](func(a, b int) bool { return a > b }).Order()
+		assert.True(t, found)
+		assert.True(t, descending(2, 1))
+
+		var zeroNames tree.Tree[name, int]
+		names, found := zeroNames.Order()
+		assert.True(t, found)
+		assert.True(t, names("a", "b"))
+		assert.False(t, names("b", "a"))
+
+		var zeroInts tree.Tree[int8, int]
+		ints, _ := zeroInts.Order()
+		assert.True(t, ints(-1, 1))
+
+		var zeroUints tree.Tree[uint, int]
+		uints, _ := zeroUints.Order()
+		assert.True(t, uints(1, 2))
+
+		var zeroFloats tree.Tree[float32, int]
+		floats, _ := zeroFloats.Order()
+		assert.True(t, floats(float32(math.NaN()), -1))
+
+		var zeroStructs tree.Tree[struct{}, int]
+		_, found = zeroStructs.Order()
+		assert.False(t, found)
+	})
+}
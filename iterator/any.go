@@ -0,0 +1,27 @@
+package iterator
+
+// Any returns true when at least one of the remaining items of the given
+// iterator satisfies the given predicate; false otherwise. It stops consuming
+// the iterator at the first item satisfying the predicate.
+func Any[T any](iter Iterator[T], predicate func(T) bool) bool {
+	for iter.HasNext() {
+		if predicate(iter.Next()) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// All returns true when all remaining items of the given iterator satisfy the
+// given predicate, which is the case for empty iterators; false otherwise. It
+// stops consuming the iterator at the first item failing the predicate.
+func All[T any](iter Iterator[T], predicate func(T) bool) bool {
+	for iter.HasNext() {
+		if !predicate(iter.Next()) {
+			return false
+		}
+	}
+
+	return true
+}
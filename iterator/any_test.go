@@ -0,0 +1,40 @@
+package iterator_test
+
+import (
+	"testing"
+
+	"github.com/gtramontina/go-extlib/iterator"
+	"github.com/gtramontina/go-extlib/testing/assert"
+)
+
+func TestAny(t *testing.T) {
+	even := func(i int) bool { return i%2 == 0 }
+
+	assert.False(t, iterator.Any(iterator.From[int](), even))
+	assert.False(t, iterator.Any(iterator.From(1, 3), even))
+	assert.True(t, iterator.Any(iterator.From(1, 2, 3), even))
+
+	t.Run("short-circuits", func(t *testing.T) {
+		assert.True(t, iterator.Any(iterator.Cycle(1, 2), even))
+
+		source := &counting{pulled: 0}
+		assert.True(t, iterator.Any[int](source, func(i int) bool { return i == 5 }))
+		assert.Eq(t, source.pulled, 6)
+	})
+}
+
+func TestAll(t *testing.T) {
+	even := func(i int) bool { return i%2 == 0 }
+
+	assert.True(t, iterator.All(iterator.From[int](), even))
+	assert.True(t, iterator.All(iterator.From(2, 4), even))
+	assert.False(t, iterator.All(iterator.From(2, 3, 4), even))
+
+	t.Run("short-circuits", func(t *testing.T) {
+		assert.False(t, iterator.All(iterator.Cycle(2, 3), even))
+
+		source := &counting{pulled: 0}
+		assert.False(t, iterator.All[int](source, func(i int) bool { return i < 5 }))
+		assert.Eq(t, source.pulled, 6)
+	})
+}
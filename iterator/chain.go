@@ -0,0 +1,83 @@
+package iterator
+
+// Chain returns an iterator yielding all items of the first given iterator,
+// then all items of the second one, and so on. It ends with the first of the
+// given iterators to end with an error, not going through the ones after it.
+func Chain[T any](iters ...Iterator[T]) Iterator[T] {
+	return &chainIterator[T]{iters: iters, all: iters}
+}
+
+// chainIterator holds the iterators left to go through in iters, and all of
+// them in all, so that they can be told about Err and Close.
+type chainIterator[T any] struct {
+	iters []Iterator[T]
+	all   []Iterator[T]
+}
+
+func (i *chainIterator[T]) HasNext() bool {
+	for len(i.iters) > 0 {
+		if i.iters[0].HasNext() {
+			return true
+		}
+
+		if Err(i.iters[0]) != nil {
+			i.iters = nil
+
+			return false
+		}
+
+		i.iters = i.iters[1:]
+	}
+
+	return false
+}
+
+func (i *chainIterator[T]) Next() T {
+	if !i.HasNext() {
+		panic(ErrIteratorEmpty)
+	}
+
+	return i.iters[0].Next()
+}
+
+func (i *chainIterator[T]) Collect() []T {
+	collected := make([]T, 0)
+	for _, iter := range i.iters {
+		collected = append(collected, iter.Collect()...)
+
+		if Err(iter) != nil {
+			break
+		}
+	}
+
+	i.iters = nil
+
+	return collected
+}
+
+// Err reports the first error among those that ended the given iterators.
+func (i *chainIterator[T]) Err() error {
+	for _, iter := range i.all {
+		if err := Err(iter); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Close closes all given iterators, returning the first error any of them
+// failed to close with.
+func (i *chainIterator[T]) Close() error {
+	var firstErr error
+
+	for _, iter := range i.all {
+		if err := Close(iter); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	i.iters = nil
+
+	return firstErr
+}
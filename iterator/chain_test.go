@@ -0,0 +1,59 @@
+package iterator_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gtramontina/go-extlib/iterator"
+	"github.com/gtramontina/go-extlib/testing/assert"
+)
+
+func TestChain(t *testing.T) {
+	t.Run("chains nothing into an empty iterator", func(t *testing.T) {
+		iter := iterator.Chain[int]()
+		assert.False(t, iter.HasNext())
+		assert.PanicsWith(t, func() { iter.Next() }, iterator.ErrIteratorEmpty)
+		assert.False(t, iterator.Chain(iterator.From[int](), iterator.From[int]()).HasNext())
+	})
+
+	t.Run("yields the items of each iterator in turn", func(t *testing.T) {
+		iter := iterator.Chain(iterator.From(1, 2), iterator.From[int](), iterator.From(3))
+		assert.Eq(t, iter.Next(), 1)
+		assert.Eq(t, iter.Next(), 2)
+		assert.True(t, iter.HasNext())
+		assert.Eq(t, iter.Next(), 3)
+		assert.False(t, iter.HasNext())
+
+		assert.DeepEqual(t, iterator.Chain(iterator.From(1, 2), iterator.From(3)).Collect(), []int{1, 2, 3})
+	})
+
+	t.Run("pulls from the next iterator only once the previous is exhausted", func(t *testing.T) {
+		source := &counting{pulled: 0}
+		iter := iterator.Chain[int](iterator.From(-1), source)
+		assert.Eq(t, iter.Next(), -1)
+		assert.Eq(t, source.pulled, 0)
+		assert.DeepEqual(t, iterator.Take(iter, 2).Collect(), []int{0, 1})
+		assert.Eq(t, source.pulled, 2)
+	})
+
+	t.Run("ends with the first error and closes all iterators", func(t *testing.T) {
+		failing := &rows{read: 0, failAt: 1, total: 3, closed: 0}
+		endless := &rows{read: 0, failAt: -1, total: -1, closed: 0}
+		iter := iterator.Chain(iterator.From(0), failing.iterator(context.Background()), endless.iterator(context.Background()))
+		assert.DeepEqual(t, iterator.Take(iter, 3).Collect(), []int{0, 1})
+		assert.True(t, errors.Is(iterator.Err(iter), errRead))
+		assert.Eq(t, endless.read, 0)
+		assert.NoError(t, iterator.Close(iter))
+		assert.Eq(t, failing.closed, 1)
+		assert.Eq(t, endless.closed, 1)
+		assert.False(t, iter.HasNext())
+
+		failing = &rows{read: 0, failAt: 1, total: 3, closed: 0}
+		endless = &rows{read: 0, failAt: -1, total: -1, closed: 0}
+		iter = iterator.Chain(failing.iterator(context.Background()), endless.iterator(context.Background()))
+		assert.DeepEqual(t, iter.Collect(), []int{1})
+		assert.True(t, errors.Is(iterator.Err(iter), errRead))
+		assert.Eq(t, endless.read, 0)
+	})
+}
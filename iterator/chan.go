@@ -0,0 +1,99 @@
+package iterator
+
+import "context"
+
+// FromChan returns an iterator yielding the items received from the given
+// channel, until it is closed. HasNext blocks until an item is received or the
+// channel is closed. See also: ToChan.
+func FromChan[T any](ch <-chan T) Iterator[T] {
+	return &chanIterator[T]{ch: ch, next: nil, done: false}
+}
+
+// ToChan returns a channel with the given buffer size, onto which the items of
+// the given iterator are sent from a goroutine of its own. The channel is
+// closed once the iterator is exhausted or the given context is done, and the
+// iterator is then closed too, when Fallible. The context is checked before
+// each item is pulled, so that no item is pulled once it is done; only the item
+// being sent when it gets done is dropped.
+//
+// The iterator must not be consulted elsewhere in the meantime, and it must
+// not panic: unlike Merge or ParMap, a plain channel has no way to raise a
+// panic again on the receiving side, so it would crash the program instead.
+// See also: FromChan.
+func ToChan[T any](ctx context.Context, it Iterator[T], buffer int) <-chan T {
+	ch := make(chan T, buffer)
+
+	go func() {
+		defer close(ch)
+		defer func() { _ = Close(it) }()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			default:
+			}
+
+			if !it.HasNext() {
+				return
+			}
+
+			item := it.Next()
+
+			select {
+			case ch <- item:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch
+}
+
+type chanIterator[T any] struct {
+	ch   <-chan T
+	next *T
+	done bool
+}
+
+func (i *chanIterator[T]) HasNext() bool {
+	if i.next != nil {
+		return true
+	}
+
+	if i.done {
+		return false
+	}
+
+	next, ok := <-i.ch
+	if !ok {
+		i.done = true
+
+		return false
+	}
+
+	i.next = &next
+
+	return true
+}
+
+func (i *chanIterator[T]) Next() T {
+	if !i.HasNext() {
+		panic(ErrIteratorEmpty)
+	}
+
+	next := *i.next
+	i.next = nil
+
+	return next
+}
+
+func (i *chanIterator[T]) Collect() []T {
+	collected := make([]T, 0)
+	for i.HasNext() {
+		collected = append(collected, i.Next())
+	}
+
+	return collected
+}
@@ -0,0 +1,93 @@
+package iterator_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gtramontina/go-extlib/iterator"
+	"github.com/gtramontina/go-extlib/testing/assert"
+)
+
+func TestFromChan(t *testing.T) {
+	t.Run("yields nothing from a closed channel", func(t *testing.T) {
+		ch := make(chan int)
+		close(ch)
+
+		iter := iterator.FromChan(ch)
+		assert.False(t, iter.HasNext())
+		assert.PanicsWith(t, func() { iter.Next() }, iterator.ErrIteratorEmpty)
+	})
+
+	t.Run("yields the items received until the channel is closed", func(t *testing.T) {
+		ch := make(chan int)
+
+		go func() {
+			defer close(ch)
+
+			for i := 1; i <= 3; i++ {
+				ch <- i
+			}
+		}()
+
+		iter := iterator.FromChan(ch)
+		assert.True(t, iter.HasNext())
+		assert.True(t, iter.HasNext())
+		assert.Eq(t, iter.Next(), 1)
+		assert.DeepEqual(t, iter.Collect(), []int{2, 3})
+		assert.False(t, iter.HasNext())
+	})
+}
+
+func TestToChan(t *testing.T) {
+	t.Run("sends all items, closing the channel after them", func(t *testing.T) {
+		ch := iterator.ToChan(context.Background(), iterator.From(1, 2, 3), 0)
+
+		var received []int
+		for item := range ch {
+			received = append(received, item)
+		}
+
+		assert.DeepEqual(t, received, []int{1, 2, 3})
+	})
+
+	t.Run("sends items ahead up to the buffer size", func(t *testing.T) {
+		ch := iterator.ToChan(context.Background(), iterator.From(1, 2, 3), 2)
+		time.Sleep(5 * time.Millisecond)
+		assert.Eq(t, len(ch), 2)
+		assert.DeepEqual(t, iterator.FromChan(ch).Collect(), []int{1, 2, 3})
+	})
+
+	t.Run("stops once the context is done, closing the iterator", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		source := &rows{read: 0, failAt: -1, total: 1000, closed: 0}
+		ch := iterator.ToChan[int](ctx, source.iterator(context.Background()), 0)
+		assert.Eq(t, <-ch, 1)
+		assert.Eq(t, <-ch, 2)
+		cancel()
+
+		for range ch { //nolint:revive // draining until closed
+		}
+
+		assert.Eq(t, source.closed, 1)
+		assert.True(t, source.read < 1000)
+	})
+
+	t.Run("pulls nothing once the context is done", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		source := &counting{pulled: 0}
+		ch := iterator.ToChan[int](ctx, source, 10)
+
+		for range ch { //nolint:revive // draining until closed
+		}
+
+		assert.Eq(t, source.pulled, 0)
+	})
+
+	t.Run("round-trips with FromChan", func(t *testing.T) {
+		ch := iterator.ToChan(context.Background(), iterator.Map(iterator.From(1, 2, 3), func(i int) int { return i * 10 }), 1)
+		assert.DeepEqual(t, iterator.FromChan(ch).Collect(), []int{10, 20, 30})
+	})
+}
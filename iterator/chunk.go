@@ -0,0 +1,52 @@
+package iterator
+
+// Chunk returns an iterator yielding the items of the given iterator in chunks
+// of the given size, the last of which may be smaller. Only the chunk being
+// built is held in memory. The given chunk size must be greater than zero,
+// otherwise it panics. See also: Window.
+func Chunk[T any](iter Iterator[T], chunkSize int) Iterator[[]T] {
+	if chunkSize < 1 {
+		panic("chunk size must be greater than 0")
+	}
+
+	return &chunkIterator[T]{iter: iter, chunkSize: chunkSize}
+}
+
+type chunkIterator[T any] struct {
+	iter      Iterator[T]
+	chunkSize int
+}
+
+func (i *chunkIterator[T]) HasNext() bool {
+	return i.iter.HasNext()
+}
+
+func (i *chunkIterator[T]) Next() []T {
+	if !i.HasNext() {
+		panic(ErrIteratorEmpty)
+	}
+
+	chunk := make([]T, 0, i.chunkSize)
+	for len(chunk) < i.chunkSize && i.iter.HasNext() {
+		chunk = append(chunk, i.iter.Next())
+	}
+
+	return chunk
+}
+
+func (i *chunkIterator[T]) Collect() [][]T {
+	collected := make([][]T, 0)
+	for i.HasNext() {
+		collected = append(collected, i.Next())
+	}
+
+	return collected
+}
+
+func (i *chunkIterator[T]) Err() error {
+	return Err(i.iter)
+}
+
+func (i *chunkIterator[T]) Close() error {
+	return Close(i.iter)
+}
@@ -0,0 +1,35 @@
+package iterator_test
+
+import (
+	"testing"
+
+	"github.com/gtramontina/go-extlib/iterator"
+	"github.com/gtramontina/go-extlib/testing/assert"
+)
+
+func TestChunk(t *testing.T) {
+	t.Run("chunks nothing from an empty iterator", func(t *testing.T) {
+		iter := iterator.Chunk(iterator.From[int](), 2)
+		assert.False(t, iter.HasNext())
+		assert.PanicsWith(t, func() { iter.Next() }, iterator.ErrIteratorEmpty)
+	})
+
+	t.Run("yields chunks of the given size, the last possibly smaller", func(t *testing.T) {
+		assert.DeepEqual(t, iterator.Chunk(iterator.From(1, 2, 3, 4, 5), 2).Collect(), [][]int{{1, 2}, {3, 4}, {5}})
+		assert.DeepEqual(t, iterator.Chunk(iterator.From(1, 2, 3, 4), 2).Collect(), [][]int{{1, 2}, {3, 4}})
+		assert.DeepEqual(t, iterator.Chunk(iterator.From(1, 2), 5).Collect(), [][]int{{1, 2}})
+	})
+
+	t.Run("pulls one chunk at a time", func(t *testing.T) {
+		source := &counting{pulled: 0}
+		iter := iterator.Chunk[int](source, 3)
+		assert.True(t, iter.HasNext())
+		assert.Eq(t, source.pulled, 0)
+		assert.DeepEqual(t, iter.Next(), []int{0, 1, 2})
+		assert.Eq(t, source.pulled, 3)
+	})
+
+	t.Run("panics on chunk sizes smaller than 1", func(t *testing.T) {
+		assert.PanicsWith(t, func() { iterator.Chunk(iterator.From(1), 0) }, "chunk size must be greater than 0")
+	})
+}
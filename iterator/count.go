@@ -0,0 +1,13 @@
+package iterator
+
+// Count returns the number of remaining items in the given iterator. It
+// consumes the iterator, so it never returns when the iterator is endless.
+func Count[T any](iter Iterator[T]) int {
+	count := 0
+	for iter.HasNext() {
+		iter.Next()
+		count++
+	}
+
+	return count
+}
@@ -0,0 +1,19 @@
+package iterator_test
+
+import (
+	"testing"
+
+	"github.com/gtramontina/go-extlib/iterator"
+	"github.com/gtramontina/go-extlib/testing/assert"
+)
+
+func TestCount(t *testing.T) {
+	assert.Eq(t, iterator.Count(iterator.From[int]()), 0)
+	assert.Eq(t, iterator.Count(iterator.From("a", "b", "c")), 3)
+	assert.Eq(t, iterator.Count(iterator.Take(iterator.Cycle(1), 7)), 7)
+
+	iter := iterator.From(1, 2, 3)
+	iter.Next()
+	assert.Eq(t, iterator.Count(iter), 2)
+	assert.False(t, iter.HasNext())
+}
@@ -0,0 +1,47 @@
+package iterator
+
+// Drop returns an iterator skipping the first n items of the given iterator
+// and yielding the rest. Items are only skipped once the returned iterator is
+// first consulted. See also: DropWhile, Take.
+func Drop[T any](iter Iterator[T], n uint) Iterator[T] {
+	return &dropIterator[T]{iter: iter, remaining: n}
+}
+
+type dropIterator[T any] struct {
+	iter      Iterator[T]
+	remaining uint
+}
+
+func (i *dropIterator[T]) HasNext() bool {
+	i.drop()
+
+	return i.iter.HasNext()
+}
+
+func (i *dropIterator[T]) Next() T {
+	i.drop()
+
+	return i.iter.Next()
+}
+
+func (i *dropIterator[T]) Collect() []T {
+	i.drop()
+
+	return i.iter.Collect()
+}
+
+func (i *dropIterator[T]) drop() {
+	for ; i.remaining > 0 && i.iter.HasNext(); i.remaining-- {
+		i.iter.Next()
+	}
+
+	i.remaining = 0
+}
+
+func (i *dropIterator[T]) Err() error {
+	return Err(i.iter)
+}
+
+func (i *dropIterator[T]) Close() error {
+	return Close(i.iter)
+}
@@ -0,0 +1,33 @@
+package iterator_test
+
+import (
+	"testing"
+
+	"github.com/gtramontina/go-extlib/iterator"
+	"github.com/gtramontina/go-extlib/testing/assert"
+)
+
+func TestDrop(t *testing.T) {
+	t.Run("drops nothing from an empty iterator", func(t *testing.T) {
+		iter := iterator.Drop(iterator.From[int](), 2)
+		assert.False(t, iter.HasNext())
+		assert.PanicsWith(t, func() { iter.Next() }, iterator.ErrIteratorEmpty)
+	})
+
+	t.Run("drops up to the given number of items", func(t *testing.T) {
+		assert.DeepEqual(t, iterator.Drop(iterator.From(1, 2, 3), 0).Collect(), []int{1, 2, 3})
+		assert.DeepEqual(t, iterator.Drop(iterator.From(1, 2, 3), 2).Collect(), []int{3})
+		assert.DeepEqual(t, iterator.Drop(iterator.From(1, 2, 3), 5).Collect(), []int{})
+	})
+
+	t.Run("drops lazily", func(t *testing.T) {
+		source := &counting{pulled: 0}
+		iter := iterator.Drop[int](source, 3)
+		assert.Eq(t, source.pulled, 0)
+		assert.True(t, iter.HasNext())
+		assert.True(t, iter.HasNext())
+		assert.Eq(t, source.pulled, 3)
+		assert.Eq(t, iter.Next(), 3)
+		assert.Eq(t, iter.Next(), 4)
+	})
+}
@@ -0,0 +1,71 @@
+package iterator
+
+// DropWhile returns an iterator skipping the items of the given iterator for
+// as long as they satisfy the given predicate, then yielding the first item
+// failing it and all the rest. Items are only skipped once the returned
+// iterator is first consulted. See also: Drop, TakeWhile.
+func DropWhile[T any](iter Iterator[T], predicate func(T) bool) Iterator[T] {
+	return &dropWhileIterator[T]{iter: iter, predicate: predicate, first: nil, dropped: false}
+}
+
+type dropWhileIterator[T any] struct {
+	iter      Iterator[T]
+	predicate func(T) bool
+	first     *T
+	dropped   bool
+}
+
+func (i *dropWhileIterator[T]) HasNext() bool {
+	i.drop()
+
+	return i.first != nil || i.iter.HasNext()
+}
+
+func (i *dropWhileIterator[T]) Next() T {
+	i.drop()
+
+	if i.first != nil {
+		first := *i.first
+		i.first = nil
+
+		return first
+	}
+
+	return i.iter.Next()
+}
+
+func (i *dropWhileIterator[T]) Collect() []T {
+	collected := make([]T, 0)
+	for i.HasNext() {
+		collected = append(collected, i.Next())
+	}
+
+	return collected
+}
+
+// drop skips items up to the first one failing the predicate, holding on to
+// it until it is yielded.
+func (i *dropWhileIterator[T]) drop() {
+	if i.dropped {
+		return
+	}
+
+	i.dropped = true
+
+	for i.iter.HasNext() {
+		next := i.iter.Next()
+		if !i.predicate(next) {
+			i.first = &next
+
+			return
+		}
+	}
+}
+
+func (i *dropWhileIterator[T]) Err() error {
+	return Err(i.iter)
+}
+
+func (i *dropWhileIterator[T]) Close() error {
+	return Close(i.iter)
+}
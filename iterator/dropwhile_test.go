@@ -0,0 +1,35 @@
+package iterator_test
+
+import (
+	"testing"
+
+	"github.com/gtramontina/go-extlib/iterator"
+	"github.com/gtramontina/go-extlib/testing/assert"
+)
+
+func TestDropWhile(t *testing.T) {
+	below := func(n int) func(int) bool { return func(i int) bool { return i < n } }
+
+	t.Run("drops nothing from an empty iterator", func(t *testing.T) {
+		iter := iterator.DropWhile(iterator.From[int](), below(3))
+		assert.False(t, iter.HasNext())
+		assert.PanicsWith(t, func() { iter.Next() }, iterator.ErrIteratorEmpty)
+	})
+
+	t.Run("drops items up to the first one failing the predicate", func(t *testing.T) {
+		assert.DeepEqual(t, iterator.DropWhile(iterator.From(1, 2, 3, 1), below(3)).Collect(), []int{3, 1})
+		assert.DeepEqual(t, iterator.DropWhile(iterator.From(1, 2, 3, 1), below(9)).Collect(), []int{})
+		assert.DeepEqual(t, iterator.DropWhile(iterator.From(1, 2, 3, 1), below(0)).Collect(), []int{1, 2, 3, 1})
+	})
+
+	t.Run("drops lazily", func(t *testing.T) {
+		source := &counting{pulled: 0}
+		iter := iterator.DropWhile[int](source, below(3))
+		assert.Eq(t, source.pulled, 0)
+		assert.True(t, iter.HasNext())
+		assert.True(t, iter.HasNext())
+		assert.Eq(t, source.pulled, 4)
+		assert.Eq(t, iter.Next(), 3)
+		assert.Eq(t, iter.Next(), 4)
+	})
+}
@@ -0,0 +1,42 @@
+package iterator
+
+import "github.com/gtramontina/go-extlib/tuple"
+
+// Enumerate returns an iterator pairing each item of the given iterator with
+// its index, starting at 0.
+func Enumerate[T any](iter Iterator[T]) Iterator[tuple.OfTwo[int, T]] {
+	return &enumerateIterator[T]{iter: iter, index: 0}
+}
+
+type enumerateIterator[T any] struct {
+	iter  Iterator[T]
+	index int
+}
+
+func (i *enumerateIterator[T]) HasNext() bool {
+	return i.iter.HasNext()
+}
+
+func (i *enumerateIterator[T]) Next() tuple.OfTwo[int, T] {
+	next := tuple.Of2(i.index, i.iter.Next())
+	i.index++
+
+	return next
+}
+
+func (i *enumerateIterator[T]) Collect() []tuple.OfTwo[int, T] {
+	collected := make([]tuple.OfTwo[int, T], 0)
+	for i.HasNext() {
+		collected = append(collected, i.Next())
+	}
+
+	return collected
+}
+
+func (i *enumerateIterator[T]) Err() error {
+	return Err(i.iter)
+}
+
+func (i *enumerateIterator[T]) Close() error {
+	return Close(i.iter)
+}
@@ -0,0 +1,23 @@
+package iterator_test
+
+import (
+	"testing"
+
+	"github.com/gtramontina/go-extlib/iterator"
+	"github.com/gtramontina/go-extlib/testing/assert"
+	"github.com/gtramontina/go-extlib/tuple"
+)
+
+func TestEnumerate(t *testing.T) {
+	t.Run("enumerates nothing from an empty iterator", func(t *testing.T) {
+		iter := iterator.Enumerate(iterator.From[string]())
+		assert.False(t, iter.HasNext())
+		assert.PanicsWith(t, func() { iter.Next() }, iterator.ErrIteratorEmpty)
+	})
+
+	t.Run("pairs items with their index", func(t *testing.T) {
+		iter := iterator.Enumerate(iterator.From("a", "b", "c"))
+		assert.DeepEqual(t, iter.Next(), tuple.Of2(0, "a"))
+		assert.DeepEqual(t, iter.Collect(), []tuple.OfTwo[int, string]{tuple.Of2(1, "b"), tuple.Of2(2, "c")})
+	})
+}
@@ -0,0 +1,159 @@
+package iterator
+
+import "context"
+
+// Fallible is an Iterator whose iteration may fail, be cancelled or hold on to
+// resources, such as database rows or files. Once its iteration ends, whether
+// exhausted, failed or cancelled, HasNext returns false and Err tells why.
+//
+// The adapters of this package, such as Map, Filter, Take, Chain or Tee, work
+// over Fallible iterators as well, passing Err and Close through. Use the Err
+// and Close functions to reach them through any of these adapters.
+type Fallible[T any] interface {
+	Iterator[T]
+
+	// Err returns the error that ended the iteration, or nil when it was
+	// exhausted or is still going.
+	Err() error
+
+	// Close ends the iteration, releasing the resources held by it. Closing
+	// an iterator more than once has no further effect.
+	Close() error
+}
+
+// NewFallible returns a Fallible iterator pulling its items from the given
+// next function, which returns false once there are no more items, or an error
+// to end the iteration with. The given context is checked before each item is
+// pulled, ending the iteration with its error once it is done. The given close
+// function, which may be nil, is called once, either when Close is called or
+// when the iteration ends. See also: WithContext.
+func NewFallible[T any](
+	ctx context.Context,
+	next func(context.Context) (T, bool, error),
+	closeFunc func() error,
+) Fallible[T] {
+	return &fallibleIterator[T]{ctx: ctx, pull: next, closeFunc: closeFunc, next: nil, err: nil, closed: false}
+}
+
+// WithContext returns a Fallible iterator yielding the items of the given
+// iterator until the given context is done. Closing it closes the given
+// iterator as well, when it is Fallible.
+func WithContext[T any](ctx context.Context, iter Iterator[T]) Fallible[T] {
+	return NewFallible(ctx, func(context.Context) (T, bool, error) {
+		if !iter.HasNext() {
+			var zero T
+
+			return zero, false, Err(iter)
+		}
+
+		return iter.Next(), true, nil
+	}, func() error {
+		return Close(iter)
+	})
+}
+
+// Err returns the error that ended the given iterator when it is Fallible, or
+// an adapter over a Fallible iterator; nil otherwise.
+func Err[T any](iter Iterator[T]) error {
+	if fallible, ok := iter.(interface{ Err() error }); ok {
+		return fallible.Err()
+	}
+
+	return nil
+}
+
+// Close closes the given iterator when it is Fallible, or an adapter over a
+// Fallible iterator; it does nothing otherwise.
+func Close[T any](iter Iterator[T]) error {
+	if fallible, ok := iter.(interface{ Close() error }); ok {
+		return fallible.Close()
+	}
+
+	return nil
+}
+
+type fallibleIterator[T any] struct {
+	ctx       context.Context //nolint:containedctx // the iteration spans several calls
+	pull      func(context.Context) (T, bool, error)
+	closeFunc func() error
+	next      *T
+	err       error
+	closed    bool
+}
+
+func (i *fallibleIterator[T]) HasNext() bool {
+	if i.next != nil {
+		return true
+	}
+
+	if i.closed {
+		return false
+	}
+
+	if err := i.ctx.Err(); err != nil {
+		i.end(err)
+
+		return false
+	}
+
+	next, ok, err := i.pull(i.ctx)
+	if err != nil || !ok {
+		i.end(err)
+
+		return false
+	}
+
+	i.next = &next
+
+	return true
+}
+
+func (i *fallibleIterator[T]) Next() T {
+	if !i.HasNext() {
+		panic(ErrIteratorEmpty)
+	}
+
+	next := *i.next
+	i.next = nil
+
+	return next
+}
+
+func (i *fallibleIterator[T]) Collect() []T {
+	collected := make([]T, 0)
+	for i.HasNext() {
+		collected = append(collected, i.Next())
+	}
+
+	return collected
+}
+
+func (i *fallibleIterator[T]) Err() error {
+	return i.err
+}
+
+func (i *fallibleIterator[T]) Close() error {
+	i.next = nil
+
+	if i.closed {
+		return nil
+	}
+
+	i.closed = true
+
+	if i.closeFunc == nil {
+		return nil
+	}
+
+	return i.closeFunc() //nolint:wrapcheck // returned as given
+}
+
+// end ends the iteration with the given error, which may be nil, closing it.
+// An error closing the iteration is only kept when there was none before.
+func (i *fallibleIterator[T]) end(err error) {
+	i.err = err
+
+	if closeErr := i.Close(); i.err == nil {
+		i.err = closeErr
+	}
+}
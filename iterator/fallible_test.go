@@ -0,0 +1,146 @@
+package iterator_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gtramontina/go-extlib/iterator"
+	"github.com/gtramontina/go-extlib/testing/assert"
+)
+
+var errRead = errors.New("read failed")
+
+// rows simulates reading rows from a database, failing after the given number
+// of rows when told to, and counting how many times it was closed.
+type rows struct {
+	read   int
+	failAt int
+	total  int
+	closed int
+}
+
+func (r *rows) iterator(ctx context.Context) iterator.Fallible[int] {
+	return iterator.NewFallible(ctx, func(context.Context) (int, bool, error) {
+		if r.read == r.failAt {
+			return 0, false, errRead
+		}
+
+		if r.read == r.total {
+			return 0, false, nil
+		}
+
+		r.read++
+
+		return r.read, true, nil
+	}, func() error {
+		r.closed++
+
+		return nil
+	})
+}
+
+func TestFallible(t *testing.T) {
+	t.Run("yields items until exhausted, closing itself", func(t *testing.T) {
+		source := &rows{read: 0, failAt: -1, total: 3, closed: 0}
+		iter := source.iterator(context.Background())
+		assert.True(t, iter.HasNext())
+		assert.True(t, iter.HasNext())
+		assert.Eq(t, source.read, 1)
+		assert.DeepEqual(t, iter.Collect(), []int{1, 2, 3})
+		assert.NoError(t, iter.Err())
+		assert.Eq(t, source.closed, 1)
+		assert.NoError(t, iter.Close())
+		assert.Eq(t, source.closed, 1)
+		assert.PanicsWith(t, func() { iter.Next() }, iterator.ErrIteratorEmpty)
+	})
+
+	t.Run("ends with the error it failed with", func(t *testing.T) {
+		source := &rows{read: 0, failAt: 2, total: 3, closed: 0}
+		iter := source.iterator(context.Background())
+		assert.DeepEqual(t, iter.Collect(), []int{1, 2})
+		assert.True(t, errors.Is(iter.Err(), errRead))
+		assert.Eq(t, source.closed, 1)
+	})
+
+	t.Run("ends once its context is done", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		source := &rows{read: 0, failAt: -1, total: 3, closed: 0}
+		iter := source.iterator(ctx)
+		assert.Eq(t, iter.Next(), 1)
+		cancel()
+		assert.False(t, iter.HasNext())
+		assert.True(t, errors.Is(iter.Err(), context.Canceled))
+		assert.Eq(t, source.read, 1)
+		assert.Eq(t, source.closed, 1)
+	})
+
+	t.Run("can be closed early", func(t *testing.T) {
+		source := &rows{read: 0, failAt: -1, total: 3, closed: 0}
+		iter := source.iterator(context.Background())
+		assert.True(t, iter.HasNext())
+		assert.NoError(t, iter.Close())
+		assert.NoError(t, iter.Close())
+		assert.False(t, iter.HasNext())
+		assert.NoError(t, iter.Err())
+		assert.Eq(t, source.closed, 1)
+	})
+
+	t.Run("reports errors closing it", func(t *testing.T) {
+		errClose := errors.New("close failed")
+		iter := iterator.NewFallible(context.Background(), func(context.Context) (int, bool, error) {
+			return 0, false, nil
+		}, func() error { return errClose })
+		assert.False(t, iter.HasNext())
+		assert.True(t, errors.Is(iter.Err(), errClose))
+	})
+
+	t.Run("bounds plain iterators by a context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		iter := iterator.WithContext(ctx, iterator.Cycle(1, 2))
+		assert.DeepEqual(t, iterator.Take[int](iter, 3).Collect(), []int{1, 2, 1})
+		cancel()
+		assert.False(t, iter.HasNext())
+		assert.True(t, errors.Is(iter.Err(), context.Canceled))
+	})
+
+	t.Run("passes errors and closing through Map and Filter", func(t *testing.T) {
+		source := &rows{read: 0, failAt: 3, total: 5, closed: 0}
+		doubled := iterator.Map[int](source.iterator(context.Background()), func(i int) int { return i * 2 })
+		even := iterator.Filter(doubled, func(i int) bool { return i > 2 })
+		assert.DeepEqual(t, even.Collect(), []int{4, 6})
+		assert.True(t, errors.Is(iterator.Err(even), errRead))
+		assert.True(t, errors.Is(iterator.Err(doubled), errRead))
+
+		source = &rows{read: 0, failAt: -1, total: 5, closed: 0}
+		mapped := iterator.Map[int](source.iterator(context.Background()), func(i int) int { return i })
+		assert.Eq(t, mapped.Next(), 1)
+		assert.NoError(t, iterator.Close(mapped))
+		assert.Eq(t, source.closed, 1)
+		assert.False(t, mapped.HasNext())
+	})
+
+	t.Run("passes errors through Tee and Split, closing once both are closed", func(t *testing.T) {
+		source := &rows{read: 0, failAt: 4, total: 5, closed: 0}
+		odd, even := iterator.Split[int](source.iterator(context.Background()), func(i int) bool { return i%2 == 1 })
+		assert.DeepEqual(t, odd.Collect(), []int{1, 3})
+		assert.DeepEqual(t, even.Collect(), []int{2, 4})
+		assert.True(t, errors.Is(iterator.Err(odd), errRead))
+		assert.True(t, errors.Is(iterator.Err(even), errRead))
+
+		source = &rows{read: 0, failAt: -1, total: 5, closed: 0}
+		left, right := iterator.Tee[int](source.iterator(context.Background()))
+		assert.Eq(t, left.Next(), 1)
+		assert.NoError(t, iterator.Close(left))
+		assert.False(t, left.HasNext())
+		assert.Eq(t, source.closed, 0)
+		assert.Eq(t, right.Next(), 1)
+		assert.NoError(t, iterator.Close(right))
+		assert.Eq(t, source.closed, 1)
+	})
+
+	t.Run("has nothing to report about plain iterators", func(t *testing.T) {
+		assert.NoError(t, iterator.Err(iterator.From(1)))
+		assert.NoError(t, iterator.Close(iterator.From(1)))
+	})
+}
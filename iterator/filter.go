@@ -4,7 +4,7 @@ package iterator
 // using the given predicate. The returned iterator will only yield items
 // for which the predicate returns true.
 func Filter[T any](iter Iterator[T], predicate func(T) bool) Iterator[T] {
-	return &filterIterator[T]{iter: iter, predicate: predicate}
+	return &filterIterator[T]{iter: iter, next: nil, predicate: predicate}
 }
 
 type filterIterator[T any] struct {
@@ -14,6 +14,10 @@ type filterIterator[T any] struct {
 }
 
 func (i *filterIterator[T]) HasNext() bool {
+	if i.next != nil {
+		return true
+	}
+
 	for i.iter.HasNext() {
 		next := i.iter.Next()
 		if i.predicate(next) {
@@ -45,3 +49,11 @@ func (i *filterIterator[T]) Collect() []T {
 
 	return collected
 }
+
+func (i *filterIterator[T]) Err() error {
+	return Err(i.iter)
+}
+
+func (i *filterIterator[T]) Close() error {
+	return Close(i.iter)
+}
@@ -33,4 +33,14 @@ func TestFilter(t *testing.T) {
 		assert.DeepEqual(t, iter.Next(), 4)
 		assert.False(t, iter.HasNext())
 	})
+
+	t.Run("does not consume items when checking for the next one", func(t *testing.T) {
+		iter := iterator.Filter(iterator.From[int](1, 2, 3, 4, 5), func(i int) bool { return i%2 == 0 })
+		assert.True(t, iter.HasNext())
+		assert.True(t, iter.HasNext())
+		assert.DeepEqual(t, iter.Next(), 2)
+		assert.True(t, iter.HasNext())
+		assert.True(t, iter.HasNext())
+		assert.DeepEqual(t, iter.Collect(), []int{4})
+	})
 }
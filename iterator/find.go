@@ -0,0 +1,36 @@
+package iterator
+
+import "github.com/gtramontina/go-extlib/maybe"
+
+// Find returns the first of the remaining items of the given iterator
+// satisfying the given predicate, or None when no item does. It stops
+// consuming the iterator as soon as the item is found.
+func Find[T any](iter Iterator[T], predicate func(T) bool) maybe.Maybe[T] {
+	for iter.HasNext() {
+		if next := iter.Next(); predicate(next) {
+			return maybe.Some(next)
+		}
+	}
+
+	return maybe.None[T]()
+}
+
+// First returns the next item of the given iterator, or None when it is empty.
+func First[T any](iter Iterator[T]) maybe.Maybe[T] {
+	if !iter.HasNext() {
+		return maybe.None[T]()
+	}
+
+	return maybe.Some(iter.Next())
+}
+
+// Last returns the last item of the given iterator, or None when it is empty.
+// It consumes the iterator, so it never returns when the iterator is endless.
+func Last[T any](iter Iterator[T]) maybe.Maybe[T] {
+	last := maybe.None[T]()
+	for iter.HasNext() {
+		last = maybe.Some(iter.Next())
+	}
+
+	return last
+}
@@ -0,0 +1,44 @@
+package iterator_test
+
+import (
+	"testing"
+
+	"github.com/gtramontina/go-extlib/iterator"
+	"github.com/gtramontina/go-extlib/maybe"
+	"github.com/gtramontina/go-extlib/testing/assert"
+)
+
+func TestFind(t *testing.T) {
+	even := func(i int) bool { return i%2 == 0 }
+
+	t.Run("finds nothing in an empty iterator", func(t *testing.T) {
+		assert.Eq(t, iterator.Find(iterator.From[int](), even), maybe.None[int]())
+	})
+
+	t.Run("finds the first item satisfying the predicate", func(t *testing.T) {
+		assert.Eq(t, iterator.Find(iterator.From(1, 3, 4, 6), even), maybe.Some(4))
+		assert.Eq(t, iterator.Find(iterator.From(1, 3, 5), even), maybe.None[int]())
+	})
+
+	t.Run("stops at the item found", func(t *testing.T) {
+		source := &counting{pulled: 0}
+		assert.Eq(t, iterator.Find[int](source, func(i int) bool { return i > 2 }), maybe.Some(3))
+		assert.Eq(t, source.pulled, 4)
+
+		iter := iterator.From(1, 2, 3)
+		iterator.Find(iter, even)
+		assert.DeepEqual(t, iter.Collect(), []int{3})
+	})
+}
+
+func TestFirst(t *testing.T) {
+	assert.Eq(t, iterator.First(iterator.From[int]()), maybe.None[int]())
+	assert.Eq(t, iterator.First(iterator.From(1, 2)), maybe.Some(1))
+	assert.Eq(t, iterator.First(iterator.Cycle(3, 4)), maybe.Some(3))
+}
+
+func TestLast(t *testing.T) {
+	assert.Eq(t, iterator.Last(iterator.From[int]()), maybe.None[int]())
+	assert.Eq(t, iterator.Last(iterator.From(1, 2)), maybe.Some(2))
+	assert.Eq(t, iterator.Last(iterator.Take(iterator.Cycle(3, 4), 3)), maybe.Some(3))
+}
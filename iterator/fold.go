@@ -0,0 +1,17 @@
+package iterator
+
+// Fold folds the remaining items of the given iterator to a single value by
+// combining them, in order, using the given function and the initial value.
+// It consumes the iterator, so it never returns when the iterator is endless.
+// See also: Reduce. Example:
+//
+//	subtract := func (a, b int) int { return a - b }
+//	_ = Fold(From(1, 2, 3, 4), subtract, 9) == ((((9 - 1) - 2) - 3) - 4)
+func Fold[In any, Out any](iter Iterator[In], f func(Out, In) Out, initial Out) Out {
+	result := initial
+	for iter.HasNext() {
+		result = f(result, iter.Next())
+	}
+
+	return result
+}
@@ -0,0 +1,28 @@
+package iterator_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/gtramontina/go-extlib/iterator"
+	"github.com/gtramontina/go-extlib/testing/assert"
+)
+
+func TestFold(t *testing.T) {
+	subtract := func(a, b int) int { return a - b }
+
+	t.Run("folds an empty iterator into the initial value", func(t *testing.T) {
+		assert.Eq(t, iterator.Fold(iterator.From[int](), subtract, 9), 9)
+	})
+
+	t.Run("folds items in order", func(t *testing.T) {
+		assert.Eq(t, iterator.Fold(iterator.From(1, 2, 3, 4), subtract, 9), (((9-1)-2)-3)-4)
+		assert.Eq(t, iterator.Fold(iterator.From(1, 2, 3), func(acc string, i int) string {
+			return acc + strconv.Itoa(i)
+		}, ">"), ">123")
+	})
+
+	t.Run("folds bounded endless iterators", func(t *testing.T) {
+		assert.Eq(t, iterator.Fold(iterator.Take(iterator.Cycle(1, 2), 5), func(a, b int) int { return a + b }, 0), 7)
+	})
+}
@@ -0,0 +1,139 @@
+package iterator
+
+import (
+	"math"
+
+	"github.com/gtramontina/go-extlib/maybe"
+	"github.com/gtramontina/go-extlib/tuple"
+	"golang.org/x/exp/constraints"
+)
+
+// FromFunc returns an iterator yielding the items returned by the given
+// function, which is called once per item, as it is asked for, until it
+// returns None. See also: Unfold.
+func FromFunc[T any](next func() maybe.Maybe[T]) Iterator[T] {
+	return &funcIterator[T]{pull: next, next: nil, done: false}
+}
+
+// Unfold returns an iterator generating its items from the given seed. The
+// given function is called with the current state, returning either None to
+// end the iteration, or the next item along with the next state. See also:
+// Iterate, FromFunc.
+func Unfold[T any, S any](seed S, unfold func(S) maybe.Maybe[tuple.OfTwo[T, S]]) Iterator[T] {
+	state := seed
+
+	return FromFunc(func() maybe.Maybe[T] {
+		return maybe.Match(unfold(state), func(next tuple.OfTwo[T, S]) maybe.Maybe[T] {
+			state = next.Get2()
+
+			return maybe.Some(next.Get1())
+		}, maybe.None[T])
+	})
+}
+
+// Iterate returns an endless iterator yielding the given seed, then the result
+// of calling the given function with it, then the result of calling it with
+// that, and so on. See also: Unfold.
+func Iterate[T any](seed T, next func(T) T) Iterator[T] {
+	current, started := seed, false
+
+	return FromFunc(func() maybe.Maybe[T] {
+		if started {
+			current = next(current)
+		}
+
+		started = true
+
+		return maybe.Some(current)
+	})
+}
+
+// Repeat returns an endless iterator yielding the given item over and over.
+// See also: Cycle.
+func Repeat[T any](item T) Iterator[T] {
+	return FromFunc(func() maybe.Maybe[T] { return maybe.Some(item) })
+}
+
+// Range returns an iterator yielding the numbers from start, inclusive, to end,
+// exclusive, counting by the given step, which may be negative to count down.
+// Each number is computed from start rather than added to the previous one, so
+// fractional steps do not accumulate rounding errors. The iteration also ends
+// once the numbers stop advancing, either because they would overflow or
+// because the step is too small to tell them apart. Panics when the step is 0
+// or when any of the arguments is NaN.
+func Range[T constraints.Integer | constraints.Float](start T, end T, step T) Iterator[T] {
+	if isNaN(start) || isNaN(end) || isNaN(step) {
+		panic("start, end and step must not be NaN")
+	}
+
+	if step == 0 {
+		panic("step must not be 0")
+	}
+
+	previous, position := start, uint64(0)
+
+	return FromFunc(func() maybe.Maybe[T] {
+		current := start + T(position)*step
+
+		advanced := position == 0 || (step > 0 && current > previous) || (step < 0 && current < previous)
+		if !advanced || (step > 0 && current >= end) || (step < 0 && current <= end) {
+			return maybe.None[T]()
+		}
+
+		previous, position = current, position+1
+
+		return maybe.Some(current)
+	})
+}
+
+func isNaN[T constraints.Integer | constraints.Float](value T) bool {
+	return math.IsNaN(float64(value))
+}
+
+type funcIterator[T any] struct {
+	pull func() maybe.Maybe[T]
+	next *T
+	done bool
+}
+
+func (i *funcIterator[T]) HasNext() bool {
+	if i.next != nil {
+		return true
+	}
+
+	if i.done {
+		return false
+	}
+
+	next := i.pull()
+	if next.IsNone() {
+		i.done = true
+
+		return false
+	}
+
+	item := next.Unwrap()
+	i.next = &item
+
+	return true
+}
+
+func (i *funcIterator[T]) Next() T {
+	if !i.HasNext() {
+		panic(ErrIteratorEmpty)
+	}
+
+	next := *i.next
+	i.next = nil
+
+	return next
+}
+
+func (i *funcIterator[T]) Collect() []T {
+	collected := make([]T, 0)
+	for i.HasNext() {
+		collected = append(collected, i.Next())
+	}
+
+	return collected
+}
@@ -0,0 +1,156 @@
+package iterator_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/gtramontina/go-extlib/iterator"
+	"github.com/gtramontina/go-extlib/maybe"
+	"github.com/gtramontina/go-extlib/testing/assert"
+	"github.com/gtramontina/go-extlib/tuple"
+)
+
+func TestFromFunc(t *testing.T) {
+	t.Run("yields nothing when the function returns None straight away", func(t *testing.T) {
+		iter := iterator.FromFunc(maybe.None[int])
+		assert.False(t, iter.HasNext())
+		assert.PanicsWith(t, func() { iter.Next() }, iterator.ErrIteratorEmpty)
+	})
+
+	t.Run("yields the items returned until None, calling the function once per item", func(t *testing.T) {
+		calls := 0
+		iter := iterator.FromFunc(func() maybe.Maybe[int] {
+			calls++
+			if calls > 3 {
+				return maybe.None[int]()
+			}
+
+			return maybe.Some(calls * 10)
+		})
+
+		assert.Eq(t, calls, 0)
+		assert.True(t, iter.HasNext())
+		assert.True(t, iter.HasNext())
+		assert.Eq(t, calls, 1)
+		assert.DeepEqual(t, iter.Collect(), []int{10, 20, 30})
+		assert.False(t, iter.HasNext())
+		assert.Eq(t, calls, 4)
+	})
+
+	t.Run("yields nil items", func(t *testing.T) {
+		yielded := false
+		iter := iterator.FromFunc(func() maybe.Maybe[*int] {
+			if yielded {
+				return maybe.None[*int]()
+			}
+
+			yielded = true
+
+			return maybe.Some[*int](nil)
+		})
+
+		assert.DeepEqual(t, iter.Collect(), []*int{nil})
+	})
+}
+
+func TestUnfold(t *testing.T) {
+	t.Run("yields nothing when unfolding ends straight away", func(t *testing.T) {
+		iter := iterator.Unfold(0, func(int) maybe.Maybe[tuple.OfTwo[string, int]] {
+			return maybe.None[tuple.OfTwo[string, int]]()
+		})
+		assert.False(t, iter.HasNext())
+	})
+
+	t.Run("yields the items unfolded from the state", func(t *testing.T) {
+		fibonacci := iterator.Unfold(tuple.Of2(0, 1), func(state tuple.OfTwo[int, int]) maybe.Maybe[tuple.OfTwo[int, tuple.OfTwo[int, int]]] {
+			return maybe.Some(tuple.Of2(state.Get1(), tuple.Of2(state.Get2(), state.Get1()+state.Get2())))
+		})
+		assert.DeepEqual(t, iterator.Take(fibonacci, 8).Collect(), []int{0, 1, 1, 2, 3, 5, 8, 13})
+
+		digits := iterator.Unfold(1234, func(n int) maybe.Maybe[tuple.OfTwo[int, int]] {
+			if n == 0 {
+				return maybe.None[tuple.OfTwo[int, int]]()
+			}
+
+			return maybe.Some(tuple.Of2(n%10, n/10))
+		})
+		assert.DeepEqual(t, digits.Collect(), []int{4, 3, 2, 1})
+	})
+}
+
+func TestIterate(t *testing.T) {
+	t.Run("yields the seed and each item computed from the one before", func(t *testing.T) {
+		calls := 0
+		powers := iterator.Iterate(1, func(n int) int {
+			calls++
+
+			return n * 2
+		})
+
+		assert.Eq(t, powers.Next(), 1)
+		assert.Eq(t, calls, 0)
+		assert.DeepEqual(t, iterator.Take(powers, 4).Collect(), []int{2, 4, 8, 16})
+		assert.Eq(t, calls, 4)
+	})
+}
+
+func TestRepeat(t *testing.T) {
+	t.Run("yields the same item endlessly", func(t *testing.T) {
+		assert.DeepEqual(t, iterator.Take(iterator.Repeat("a"), 3).Collect(), []string{"a", "a", "a"})
+	})
+}
+
+func TestRange(t *testing.T) {
+	t.Run("yields nothing for an empty range", func(t *testing.T) {
+		assert.False(t, iterator.Range(0, 0, 1).HasNext())
+		assert.False(t, iterator.Range(3, 1, 1).HasNext())
+		assert.False(t, iterator.Range(1, 3, -1).HasNext())
+	})
+
+	t.Run("counts up from start to end, exclusive", func(t *testing.T) {
+		assert.DeepEqual(t, iterator.Range(0, 5, 1).Collect(), []int{0, 1, 2, 3, 4})
+		assert.DeepEqual(t, iterator.Range(0, 10, 3).Collect(), []int{0, 3, 6, 9})
+		assert.DeepEqual(t, iterator.Range(-2, 2, 2).Collect(), []int{-2, 0})
+	})
+
+	t.Run("counts down with a negative step", func(t *testing.T) {
+		assert.DeepEqual(t, iterator.Range(5, 0, -2).Collect(), []int{5, 3, 1})
+	})
+
+	t.Run("counts by fractions", func(t *testing.T) {
+		assert.DeepEqual(t, iterator.Range(0.0, 2.0, 0.5).Collect(), []float64{0, 0.5, 1, 1.5})
+	})
+
+	t.Run("does not accumulate rounding errors", func(t *testing.T) {
+		tenths := iterator.Range(0.0, 1.0, 0.1).Collect()
+		assert.Eq(t, len(tenths), 10)
+		assert.Eq(t, tenths[3], 0.30000000000000004)
+
+		for _, tenth := range tenths {
+			assert.True(t, tenth < 1.0)
+		}
+	})
+
+	t.Run("ends once the numbers stop advancing", func(t *testing.T) {
+		assert.DeepEqual(t, iterator.Range(1e17, 2e17, 1.0).Collect(), []float64{1e17})
+		assert.DeepEqual(t, iterator.Range[float32](1e8, 2e8, 1).Collect(), []float32{1e8})
+	})
+
+	t.Run("ends before overflowing", func(t *testing.T) {
+		assert.DeepEqual(t, iterator.Range[int8](0, 127, 100).Collect(), []int8{0, 100})
+		assert.DeepEqual(t, iterator.Range[uint8](10, 0, 255-5).Collect(), []uint8{})
+		assert.DeepEqual(t, iterator.Range[uint8](250, 255, 4).Collect(), []uint8{250, 254})
+		assert.DeepEqual(t, iterator.Range[int8](-100, -128, -100).Collect(), []int8{-100})
+		assert.Eq(t, len(iterator.Range[uint8](0, 255, 1).Collect()), 255)
+	})
+
+	t.Run("panics on a step of 0", func(t *testing.T) {
+		assert.PanicsWith(t, func() { iterator.Range(0, 1, 0) }, "step must not be 0")
+	})
+
+	t.Run("panics on NaN", func(t *testing.T) {
+		assert.PanicsWith(t, func() { iterator.Range(math.NaN(), 1, 0.1) }, "start, end and step must not be NaN")
+		assert.PanicsWith(t, func() { iterator.Range(0, math.NaN(), 0.1) }, "start, end and step must not be NaN")
+		assert.PanicsWith(t, func() { iterator.Range(0, 1, math.NaN()) }, "start, end and step must not be NaN")
+	})
+}
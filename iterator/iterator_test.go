@@ -0,0 +1,119 @@
+package iterator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gtramontina/go-extlib/iterator"
+	"github.com/gtramontina/go-extlib/maybe"
+	"github.com/gtramontina/go-extlib/testing/assert"
+	"github.com/gtramontina/go-extlib/tuple"
+)
+
+func TestHasNext(t *testing.T) {
+	even := func(i int) bool { return i%2 == 0 }
+	small := func(i int) bool { return i < 4 }
+	double := func(i int) int { return 2 * i }
+	sum := func(items []int) int { return items[0] + items[len(items)-1] }
+
+	adapters := map[string]func() iterator.Iterator[int]{
+		"slice":     func() iterator.Iterator[int] { return iterator.From(1, 2, 3, 4, 5, 6) },
+		"map":       func() iterator.Iterator[int] { return iterator.Map(iterator.From(1, 2, 3), double) },
+		"filter":    func() iterator.Iterator[int] { return iterator.Filter(iterator.From(1, 2, 3, 4, 5, 6), even) },
+		"take":      func() iterator.Iterator[int] { return iterator.Take(iterator.From(1, 2, 3, 4), 3) },
+		"drop":      func() iterator.Iterator[int] { return iterator.Drop(iterator.From(1, 2, 3, 4), 1) },
+		"takewhile": func() iterator.Iterator[int] { return iterator.TakeWhile(iterator.From(1, 2, 3, 4, 5), small) },
+		"dropwhile": func() iterator.Iterator[int] { return iterator.DropWhile(iterator.From(1, 2, 3, 4, 5), small) },
+		"chain":     func() iterator.Iterator[int] { return iterator.Chain(iterator.From(1), iterator.From(2, 3)) },
+		"stepby":    func() iterator.Iterator[int] { return iterator.StepBy(iterator.From(1, 2, 3, 4, 5), 2) },
+		"chunk": func() iterator.Iterator[int] {
+			return iterator.Map(iterator.Chunk(iterator.From(1, 2, 3, 4, 5), 2), sum)
+		},
+		"window": func() iterator.Iterator[int] { return iterator.Map(iterator.Window(iterator.From(1, 2, 3, 4), 2), sum) },
+		"tee": func() iterator.Iterator[int] {
+			iter, _ := iterator.Tee(iterator.From(1, 2, 3))
+
+			return iter
+		},
+		"split": func() iterator.Iterator[int] {
+			iter, _ := iterator.Split(iterator.From(1, 2, 3, 4), even)
+
+			return iter
+		},
+		"peekable": func() iterator.Iterator[int] { return iterator.NewPeekable(iterator.From(1, 2, 3), 1) },
+		"fallible": func() iterator.Iterator[int] {
+			return iterator.WithContext(context.Background(), iterator.From(1, 2, 3))
+		},
+		"seq": func() iterator.Iterator[int] {
+			return iterator.FromSeq(iterator.Seq(iterator.From(1, 2, 3)))
+		},
+		"par": func() iterator.Iterator[int] { return iterator.ParMap(iterator.From(1, 2, 3), 2, double) },
+		"parfilter": func() iterator.Iterator[int] {
+			return iterator.ParFilter(iterator.From(1, 2, 3, 4, 5, 6), 2, even)
+		},
+		"cycle": func() iterator.Iterator[int] { return iterator.Take(iterator.Cycle(1, 2), 5) },
+		"enumerate": func() iterator.Iterator[int] {
+			return iterator.Map(iterator.Enumerate(iterator.From(4, 5, 6)), func(item tuple.OfTwo[int, int]) int {
+				return item.Get1() + item.Get2()
+			})
+		},
+		"chan": func() iterator.Iterator[int] {
+			ch := make(chan int, 3)
+			ch <- 1
+			ch <- 2
+			ch <- 3
+			close(ch)
+
+			return iterator.FromChan(ch)
+		},
+		"merge": func() iterator.Iterator[int] {
+			// Merged items come in no particular order, so all of them are equal.
+			return iterator.Merge(iterator.From(1, 1), iterator.From(1, 1, 1))
+		},
+		"broadcast": func() iterator.Iterator[int] { return iterator.Broadcast(iterator.From(1, 2, 3), 3)[1] },
+		"range":     func() iterator.Iterator[int] { return iterator.Range(0, 10, 3) },
+		"repeat":    func() iterator.Iterator[int] { return iterator.Take(iterator.Repeat(7), 3) },
+		"iterate":   func() iterator.Iterator[int] { return iterator.Take(iterator.Iterate(1, double), 4) },
+		"unfold": func() iterator.Iterator[int] {
+			return iterator.Unfold(3, func(n int) maybe.Maybe[tuple.OfTwo[int, int]] {
+				if n == 0 {
+					return maybe.None[tuple.OfTwo[int, int]]()
+				}
+
+				return maybe.Some(tuple.Of2(n, n-1))
+			})
+		},
+		"func": func() iterator.Iterator[int] {
+			items := []int{1, 2, 3}
+
+			return iterator.FromFunc(func() maybe.Maybe[int] {
+				if len(items) == 0 {
+					return maybe.None[int]()
+				}
+
+				item := items[0]
+				items = items[1:]
+
+				return maybe.Some(item)
+			})
+		},
+	}
+
+	for name, adapter := range adapters {
+		t.Run(name+" does not consume items when checking for the next one", func(t *testing.T) {
+			expected := adapter().Collect()
+
+			iter := adapter()
+			collected := make([]int, 0)
+
+			for iter.HasNext() {
+				assert.True(t, iter.HasNext())
+				collected = append(collected, iter.Next())
+			}
+
+			assert.False(t, iter.HasNext())
+			assert.DeepEqual(t, collected, expected)
+			assert.True(t, len(collected) > 0)
+		})
+	}
+}
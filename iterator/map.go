@@ -1,7 +1,5 @@
 package iterator
 
-import "github.com/gtramontina/go-extlib/collections"
-
 // Map calls the provided mapper function once for each element in the given
 // iterator, in order, and constructs a new iterator from the results.
 func Map[From any, To any](collection Iterator[From], mapper func(it From) To) Iterator[To] {
@@ -22,5 +20,20 @@ func (i *iterableMap[From, To]) Next() To {
 }
 
 func (i *iterableMap[From, To]) Collect() []To {
-	return collections.Map[From, To](i.collection.Collect(), i.mapper)
+	remaining := i.collection.Collect()
+	mapped := make([]To, 0, len(remaining))
+
+	for _, element := range remaining {
+		mapped = append(mapped, i.mapper(element))
+	}
+
+	return mapped
+}
+
+func (i *iterableMap[From, To]) Err() error {
+	return Err(i.collection)
+}
+
+func (i *iterableMap[From, To]) Close() error {
+	return Close(i.collection)
 }
@@ -0,0 +1,191 @@
+package iterator
+
+import "sync"
+
+// Merge returns an iterator yielding the items of all given iterators as they
+// become available, each of them being consulted from a goroutine of its own.
+// Items of the same iterator keep their order, but items of different ones
+// are interleaved in no particular order. The given iterators must not be
+// consulted elsewhere in the meantime.
+//
+// Work only starts once the returned iterator is first consulted. A panic in
+// any of the given iterators is raised again by the returned iterator. An
+// iterator not consumed to the end must be closed, through Close, to stop its
+// goroutines; closing it closes the given iterators as well, when Fallible.
+// Err reports the first error among those that ended the given iterators.
+func Merge[T any](iters ...Iterator[T]) Iterator[T] {
+	return &mergeIterator[T]{
+		iters:    iters,
+		started:  false,
+		finished: false,
+		closed:   false,
+		done:     nil,
+		items:    nil,
+		next:     nil,
+	}
+}
+
+// mergeItem is an item pulled by one of the goroutines, or what was recovered
+// from a panic pulling it.
+type mergeItem[T any] struct {
+	value     T
+	recovered any
+}
+
+type mergeIterator[T any] struct {
+	iters    []Iterator[T]
+	started  bool
+	finished bool
+	closed   bool
+	done     chan struct{}
+	items    chan mergeItem[T]
+	next     *T
+}
+
+func (i *mergeIterator[T]) HasNext() bool {
+	if i.next != nil {
+		return true
+	}
+
+	if i.finished {
+		return false
+	}
+
+	i.start()
+
+	item, ok := <-i.items
+	if !ok {
+		i.finished = true
+
+		return false
+	}
+
+	if item.recovered != nil {
+		_ = i.Close()
+
+		panic(item.recovered)
+	}
+
+	i.next = &item.value
+
+	return true
+}
+
+func (i *mergeIterator[T]) Next() T {
+	if !i.HasNext() {
+		panic(ErrIteratorEmpty)
+	}
+
+	next := *i.next
+	i.next = nil
+
+	return next
+}
+
+func (i *mergeIterator[T]) Collect() []T {
+	collected := make([]T, 0)
+	for i.HasNext() {
+		collected = append(collected, i.Next())
+	}
+
+	return collected
+}
+
+// Err only consults the given iterators once all goroutines are gone, which is
+// when the iteration is finished.
+func (i *mergeIterator[T]) Err() error {
+	if !i.finished {
+		return nil
+	}
+
+	for _, iter := range i.iters {
+		if err := Err(iter); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (i *mergeIterator[T]) Close() error {
+	if i.closed {
+		return nil
+	}
+
+	i.closed, i.finished, i.next = true, true, nil
+
+	if i.started {
+		close(i.done)
+
+		for range i.items { //nolint:revive // draining until all goroutines are gone
+		}
+	}
+
+	var firstErr error
+
+	for _, iter := range i.iters {
+		if err := Close(iter); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	return firstErr
+}
+
+// start starts a goroutine for each of the given iterators, unless already
+// started.
+func (i *mergeIterator[T]) start() {
+	if i.started {
+		return
+	}
+
+	i.started = true
+	i.done = make(chan struct{})
+	i.items = make(chan mergeItem[T], len(i.iters))
+
+	var running sync.WaitGroup
+
+	running.Add(len(i.iters))
+
+	for _, iter := range i.iters {
+		go func() {
+			defer running.Done()
+
+			i.pull(iter)
+		}()
+	}
+
+	go func() {
+		running.Wait()
+		close(i.items)
+	}()
+}
+
+// pull sends the items of the given iterator until it is exhausted or the
+// consumer is done. A panic pulling an item is sent in its place.
+func (i *mergeIterator[T]) pull(iter Iterator[T]) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			var zero T
+
+			i.send(mergeItem[T]{value: zero, recovered: recovered})
+		}
+	}()
+
+	for iter.HasNext() {
+		if !i.send(mergeItem[T]{value: iter.Next(), recovered: nil}) {
+			return
+		}
+	}
+}
+
+// send hands the given item to the consumer, returning false when the consumer
+// is done instead.
+func (i *mergeIterator[T]) send(item mergeItem[T]) bool {
+	select {
+	case i.items <- item:
+		return true
+	case <-i.done:
+		return false
+	}
+}
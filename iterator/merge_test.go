@@ -0,0 +1,82 @@
+package iterator_test
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/gtramontina/go-extlib/iterator"
+	"github.com/gtramontina/go-extlib/testing/assert"
+)
+
+func TestMerge(t *testing.T) {
+	t.Run("merges nothing into an empty iterator", func(t *testing.T) {
+		assert.False(t, iterator.Merge[int]().HasNext())
+
+		iter := iterator.Merge(iterator.From[int](), iterator.From[int]())
+		assert.False(t, iter.HasNext())
+		assert.PanicsWith(t, func() { iter.Next() }, iterator.ErrIteratorEmpty)
+	})
+
+	t.Run("yields all items of all iterators", func(t *testing.T) {
+		merged := iterator.Merge(iterator.From(1, 2, 3), iterator.From[int](), iterator.From(4, 5)).Collect()
+		sort.Ints(merged)
+		assert.DeepEqual(t, merged, []int{1, 2, 3, 4, 5})
+	})
+
+	t.Run("keeps the order of items of the same iterator", func(t *testing.T) {
+		var odd, even []int
+
+		for _, item := range iterator.Merge(iterator.From(1, 3, 5, 7), iterator.From(2, 4, 6, 8)).Collect() {
+			if item%2 == 1 {
+				odd = append(odd, item)
+			} else {
+				even = append(even, item)
+			}
+		}
+
+		assert.DeepEqual(t, odd, []int{1, 3, 5, 7})
+		assert.DeepEqual(t, even, []int{2, 4, 6, 8})
+	})
+
+	t.Run("does not wait for slow iterators to yield the others", func(t *testing.T) {
+		slow := make(chan int)
+		iter := iterator.Merge(iterator.FromChan(slow), iterator.From(1))
+		assert.Eq(t, iter.Next(), 1)
+
+		slow <- 2
+		close(slow)
+
+		assert.DeepEqual(t, iter.Collect(), []int{2})
+	})
+
+	t.Run("raises panics again", func(t *testing.T) {
+		failing := iterator.Map(iterator.From(1, 0), func(i int) int { return 1 / i })
+		assert.Panics(t, func() { iterator.Merge(iterator.Cycle(1), failing).Collect() })
+	})
+
+	t.Run("passes errors through, closing all iterators once closed", func(t *testing.T) {
+		source := &rows{read: 0, failAt: 2, total: 3, closed: 0}
+		iter := iterator.Merge[int](source.iterator(context.Background()), iterator.From(9))
+		assert.Eq(t, len(iter.Collect()), 3)
+		assert.True(t, errors.Is(iterator.Err(iter), errRead))
+
+		before := runtime.NumGoroutine()
+		endless := &rows{read: 0, failAt: -1, total: -1, closed: 0}
+		iter = iterator.Merge[int](endless.iterator(context.Background()), iterator.Cycle(0))
+		assert.True(t, iter.HasNext())
+		assert.NoError(t, iterator.Close(iter))
+		assert.NoError(t, iterator.Close(iter))
+		assert.False(t, iter.HasNext())
+		assert.Eq(t, endless.closed, 1)
+
+		for i := 0; i < 100 && runtime.NumGoroutine() > before; i++ {
+			time.Sleep(time.Millisecond)
+		}
+
+		assert.True(t, runtime.NumGoroutine() <= before)
+	})
+}
@@ -0,0 +1,34 @@
+package iterator
+
+import (
+	"github.com/gtramontina/go-extlib/maybe"
+	"golang.org/x/exp/constraints"
+)
+
+// Min returns the smallest of the remaining items of the given iterator, or
+// None when it is empty. When several items are equally small, the first one
+// is returned. It consumes the iterator, so it never returns when the iterator
+// is endless. See also: Max.
+func Min[T constraints.Ordered](iter Iterator[T]) maybe.Maybe[T] {
+	return Reduce(iter, func(smallest T, item T) T {
+		if item < smallest {
+			return item
+		}
+
+		return smallest
+	})
+}
+
+// Max returns the largest of the remaining items of the given iterator, or
+// None when it is empty. When several items are equally large, the first one
+// is returned. It consumes the iterator, so it never returns when the iterator
+// is endless. See also: Min.
+func Max[T constraints.Ordered](iter Iterator[T]) maybe.Maybe[T] {
+	return Reduce(iter, func(largest T, item T) T {
+		if item > largest {
+			return item
+		}
+
+		return largest
+	})
+}
@@ -0,0 +1,23 @@
+package iterator_test
+
+import (
+	"testing"
+
+	"github.com/gtramontina/go-extlib/iterator"
+	"github.com/gtramontina/go-extlib/maybe"
+	"github.com/gtramontina/go-extlib/testing/assert"
+)
+
+func TestMin(t *testing.T) {
+	assert.Eq(t, iterator.Min(iterator.From[int]()), maybe.None[int]())
+	assert.Eq(t, iterator.Min(iterator.From(3, 1, 2)), maybe.Some(1))
+	assert.Eq(t, iterator.Min(iterator.From(-1.5, 2.0)), maybe.Some(-1.5))
+	assert.Eq(t, iterator.Min(iterator.From("b", "c", "a")), maybe.Some("a"))
+}
+
+func TestMax(t *testing.T) {
+	assert.Eq(t, iterator.Max(iterator.From[int]()), maybe.None[int]())
+	assert.Eq(t, iterator.Max(iterator.From(3, 1, 2)), maybe.Some(3))
+	assert.Eq(t, iterator.Max(iterator.From(-1.5, 2.0)), maybe.Some(2.0))
+	assert.Eq(t, iterator.Max(iterator.From("b", "c", "a")), maybe.Some("c"))
+}
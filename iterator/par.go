@@ -0,0 +1,347 @@
+package iterator
+
+import "sync"
+
+// ParOption customises how ParMap and ParFilter deliver their items.
+type ParOption func(parConfig) parConfig
+
+type parConfig struct {
+	unordered bool
+}
+
+// Unordered makes ParMap and ParFilter yield items as soon as they are ready,
+// instead of in the order of the underlying iterator. A slow item then holds
+// back no other.
+func Unordered() ParOption {
+	return func(c parConfig) parConfig {
+		c.unordered = true
+
+		return c
+	}
+}
+
+func newParConfig(options []ParOption) parConfig {
+	c := parConfig{unordered: false}
+	for _, option := range options {
+		c = option(c)
+	}
+
+	return c
+}
+
+// ParMap returns an iterator yielding the items of the given iterator mapped
+// by the given mapper function, which is called concurrently by the given
+// number of workers. Items are yielded in the order of the given iterator,
+// unless the Unordered option is given.
+//
+// Work only starts once the returned iterator is first consulted, and at most
+// twice as many items as there are workers are pulled ahead of those yielded,
+// so a slow consumer holds the workers back. The given iterator is only ever
+// consulted by a single goroutine, and so are the returned iterator's methods
+// meant to be. A panic in the mapper function, or in the given iterator, is
+// raised again by the returned iterator when the item it happened at is due.
+//
+// An iterator not consumed to the end must be closed, through Close, to stop
+// its workers. Closing it closes the given iterator as well, when Fallible,
+// and Err reports the error that ended the given iterator. Since the given
+// iterator is not safe to close while an item is being pulled from it, Close
+// waits for any pull in progress to return first: a given iterator blocked on
+// a channel, for instance, must be unblocked, by closing the channel or
+// cancelling its context, for Close to return. Panics when the number of
+// workers is smaller than 1. See also: Map, ParFilter.
+func ParMap[From any, To any](
+	iter Iterator[From],
+	workers int,
+	mapper func(From) To,
+	options ...ParOption,
+) Iterator[To] {
+	return newParallel(iter, workers, func(item From) (To, bool) {
+		return mapper(item), true
+	}, newParConfig(options))
+}
+
+// ParFilter returns an iterator yielding the items of the given iterator for
+// which the given predicate, called concurrently by the given number of
+// workers, returns true. It behaves just like ParMap otherwise. See also:
+// Filter, ParMap.
+func ParFilter[T any](iter Iterator[T], workers int, predicate func(T) bool, options ...ParOption) Iterator[T] {
+	return newParallel(iter, workers, func(item T) (T, bool) {
+		return item, predicate(item)
+	}, newParConfig(options))
+}
+
+// parItem is an item handed from the feeder to the workers, and from the
+// workers to the consumer. An item whose work panicked holds what was
+// recovered.
+type parItem[T any] struct {
+	index     int
+	value     T
+	keep      bool
+	recovered any
+}
+
+// parallel runs a feeder goroutine pulling items from the underlying iterator,
+// and a pool of workers applying the work to them. The number of items in
+// flight is bounded by tokens, acquired by the feeder before pulling an item
+// and released by the consumer once the item is done with.
+type parallel[From any, To any] struct {
+	iter    Iterator[From]
+	workers int
+	work    func(From) (To, bool)
+	config  parConfig
+
+	started  bool
+	finished bool
+	closed   bool
+	tokens   chan struct{}
+	done     chan struct{}
+	results  chan parItem[To]
+	pending  map[int]parItem[To]
+	due      int
+	next     *To
+	err      error
+}
+
+func newParallel[From any, To any](
+	iter Iterator[From],
+	workers int,
+	work func(From) (To, bool),
+	config parConfig,
+) *parallel[From, To] {
+	if workers < 1 {
+		panic("workers must be greater than 0")
+	}
+
+	return &parallel[From, To]{
+		iter:     iter,
+		workers:  workers,
+		work:     work,
+		config:   config,
+		started:  false,
+		finished: false,
+		closed:   false,
+		tokens:   nil,
+		done:     nil,
+		results:  nil,
+		pending:  map[int]parItem[To]{},
+		due:      0,
+		next:     nil,
+		err:      nil,
+	}
+}
+
+func (p *parallel[From, To]) HasNext() bool {
+	if p.next != nil {
+		return true
+	}
+
+	if p.finished {
+		return false
+	}
+
+	p.start()
+
+	for {
+		item, ok := p.receive()
+		if !ok {
+			p.finished = true
+
+			return false
+		}
+
+		<-p.tokens
+
+		if item.recovered != nil {
+			_ = p.Close()
+
+			panic(item.recovered)
+		}
+
+		if item.keep {
+			p.next = &item.value
+
+			return true
+		}
+	}
+}
+
+func (p *parallel[From, To]) Next() To {
+	if !p.HasNext() {
+		panic(ErrIteratorEmpty)
+	}
+
+	next := *p.next
+	p.next = nil
+
+	return next
+}
+
+func (p *parallel[From, To]) Collect() []To {
+	collected := make([]To, 0)
+	for p.HasNext() {
+		collected = append(collected, p.Next())
+	}
+
+	return collected
+}
+
+// Err only reports the error recorded by the feeder once all goroutines are
+// gone, which is when the iteration is finished.
+func (p *parallel[From, To]) Err() error {
+	if !p.finished {
+		return nil
+	}
+
+	return p.err
+}
+
+func (p *parallel[From, To]) Close() error {
+	if p.closed {
+		return nil
+	}
+
+	p.closed, p.finished, p.next = true, true, nil
+
+	if p.started {
+		close(p.done)
+
+		// The feeder may be blocked pulling from the underlying iterator, which
+		// only it consults, so it is waited for rather than closed under it.
+		for range p.results { //nolint:revive // draining until all goroutines are gone
+		}
+	}
+
+	return Close(p.iter)
+}
+
+// start starts the feeder and the workers, unless already started.
+func (p *parallel[From, To]) start() {
+	if p.started {
+		return
+	}
+
+	p.started = true
+	p.tokens = make(chan struct{}, 2*p.workers)
+	p.done = make(chan struct{})
+	p.results = make(chan parItem[To], 2*p.workers)
+
+	jobs := make(chan parItem[From], p.workers)
+
+	var running sync.WaitGroup
+
+	running.Add(p.workers + 1)
+
+	go func() {
+		defer running.Done()
+		defer close(jobs)
+
+		p.feed(jobs)
+	}()
+
+	for worker := 0; worker < p.workers; worker++ {
+		go func() {
+			defer running.Done()
+
+			for job := range jobs {
+				if !p.send(p.apply(job)) {
+					return
+				}
+			}
+		}()
+	}
+
+	go func() {
+		running.Wait()
+		close(p.results)
+	}()
+}
+
+// feed pulls items from the underlying iterator and hands them to the workers
+// until it is exhausted or the consumer is done, recording the error that
+// ended it. A panic pulling an item is handed straight to the consumer.
+func (p *parallel[From, To]) feed(jobs chan<- parItem[From]) {
+	index := 0
+
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			var zero To
+
+			p.send(parItem[To]{index: index, value: zero, keep: false, recovered: recovered})
+		}
+	}()
+
+	for {
+		select {
+		case p.tokens <- struct{}{}:
+		case <-p.done:
+			return
+		}
+
+		if !p.iter.HasNext() {
+			<-p.tokens
+
+			p.err = Err(p.iter)
+
+			return
+		}
+
+		job := parItem[From]{index: index, value: p.iter.Next(), keep: true, recovered: nil}
+
+		select {
+		case jobs <- job:
+			index++
+		case <-p.done:
+			return
+		}
+	}
+}
+
+// apply applies the work to the given job, recovering from any panic.
+func (p *parallel[From, To]) apply(job parItem[From]) (item parItem[To]) {
+	item.index = job.index
+
+	defer func() {
+		item.recovered = recover()
+	}()
+
+	item.value, item.keep = p.work(job.value)
+
+	return item
+}
+
+// send hands the given item to the consumer, returning false when the consumer
+// is done instead.
+func (p *parallel[From, To]) send(item parItem[To]) bool {
+	select {
+	case p.results <- item:
+		return true
+	case <-p.done:
+		return false
+	}
+}
+
+// receive returns the next item done, which is the one due unless unordered.
+// The boolean result reports whether there was any item left.
+func (p *parallel[From, To]) receive() (parItem[To], bool) {
+	if p.config.unordered {
+		item, ok := <-p.results
+
+		return item, ok
+	}
+
+	for {
+		if item, ok := p.pending[p.due]; ok {
+			delete(p.pending, p.due)
+			p.due++
+
+			return item, true
+		}
+
+		item, ok := <-p.results
+		if !ok {
+			return item, false
+		}
+
+		p.pending[item.index] = item
+	}
+}
@@ -0,0 +1,177 @@
+package iterator_test
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"sort"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gtramontina/go-extlib/iterator"
+	"github.com/gtramontina/go-extlib/testing/assert"
+)
+
+func TestParMap(t *testing.T) {
+	square := func(i int) int { return i * i }
+
+	t.Run("maps nothing from an empty iterator", func(t *testing.T) {
+		iter := iterator.ParMap(iterator.From[int](), 4, square)
+		assert.False(t, iter.HasNext())
+		assert.PanicsWith(t, func() { iter.Next() }, iterator.ErrIteratorEmpty)
+	})
+
+	t.Run("maps items in order", func(t *testing.T) {
+		source := make([]int, 0, 1000)
+		expected := make([]int, 0, 1000)
+
+		for i := 0; i < 1000; i++ {
+			source = append(source, i)
+			expected = append(expected, i*i)
+		}
+
+		assert.DeepEqual(t, iterator.ParMap(iterator.FromSlice(source), 8, square).Collect(), expected)
+		assert.DeepEqual(t, iterator.ParMap(iterator.FromSlice(source), 1, square).Collect(), expected)
+	})
+
+	t.Run("keeps the order even when later items are done first", func(t *testing.T) {
+		slowFirst := func(i int) int {
+			if i == 0 {
+				time.Sleep(20 * time.Millisecond)
+			}
+
+			return i
+		}
+
+		assert.DeepEqual(t, iterator.ParMap(iterator.From(0, 1, 2, 3), 4, slowFirst).Collect(), []int{0, 1, 2, 3})
+
+		unordered := iterator.ParMap(iterator.From(0, 1, 2, 3), 4, slowFirst, iterator.Unordered()).Collect()
+		assert.Eq(t, unordered[len(unordered)-1], 0)
+
+		sort.Ints(unordered)
+		assert.DeepEqual(t, unordered, []int{0, 1, 2, 3})
+	})
+
+	t.Run("runs the given number of workers at once", func(t *testing.T) {
+		var running, peak atomic.Int32
+
+		iterator.ParMap(iterator.FromSlice(make([]int, 40)), 4, func(i int) int {
+			now := running.Add(1)
+			for {
+				seen := peak.Load()
+				if now <= seen || peak.CompareAndSwap(seen, now) {
+					break
+				}
+			}
+
+			time.Sleep(time.Millisecond)
+			running.Add(-1)
+
+			return i
+		}).Collect()
+
+		assert.True(t, peak.Load() > 1)
+		assert.True(t, peak.Load() <= 4)
+	})
+
+	t.Run("pulls a bounded number of items ahead of the consumer", func(t *testing.T) {
+		source := &counting{pulled: 0}
+		iter := iterator.ParMap[int](source, 2, square)
+		assert.Eq(t, iter.Next(), 0)
+		time.Sleep(10 * time.Millisecond)
+		assert.NoError(t, iterator.Close(iter))
+		assert.True(t, source.pulled <= 5)
+	})
+
+	t.Run("raises panics again when their item is due", func(t *testing.T) {
+		iter := iterator.ParMap(iterator.From(1, 2, 0, 4), 2, func(i int) int { return 4 / i })
+		assert.Eq(t, iter.Next(), 4)
+		assert.Eq(t, iter.Next(), 2)
+		assert.Panics(t, func() { iter.Next() })
+		assert.False(t, iter.HasNext())
+
+		failing := iterator.Map(iterator.From(1, 0), func(i int) int { return 4 / i })
+		assert.Panics(t, func() { iterator.ParMap(failing, 2, square).Collect() })
+	})
+
+	t.Run("stops its workers once closed", func(t *testing.T) {
+		before := runtime.NumGoroutine()
+
+		iter := iterator.ParMap(iterator.Cycle(1, 2, 3), 8, square)
+		assert.DeepEqual(t, iterator.Take(iter, 3).Collect(), []int{1, 4, 9})
+		assert.NoError(t, iterator.Close(iter))
+		assert.NoError(t, iterator.Close(iter))
+		assert.False(t, iter.HasNext())
+
+		for i := 0; i < 100 && runtime.NumGoroutine() > before; i++ {
+			time.Sleep(time.Millisecond)
+		}
+
+		assert.True(t, runtime.NumGoroutine() <= before)
+	})
+
+	t.Run("passes errors and closing through", func(t *testing.T) {
+		source := &rows{read: 0, failAt: 3, total: 5, closed: 0}
+		iter := iterator.ParMap[int](source.iterator(context.Background()), 2, square)
+		assert.DeepEqual(t, iter.Collect(), []int{1, 4, 9})
+		assert.True(t, errors.Is(iterator.Err(iter), errRead))
+
+		source = &rows{read: 0, failAt: -1, total: 5, closed: 0}
+		iter = iterator.ParMap[int](source.iterator(context.Background()), 2, square)
+		assert.Eq(t, iter.Next(), 1)
+		assert.NoError(t, iterator.Close(iter))
+		assert.Eq(t, source.closed, 1)
+	})
+
+	t.Run("waits for a blocked pull to return once closed", func(t *testing.T) {
+		ch := make(chan int)
+		iter := iterator.ParMap(iterator.FromChan(ch), 2, square)
+
+		go func() { ch <- 2 }()
+
+		assert.Eq(t, iter.Next(), 4)
+
+		closed := make(chan error)
+
+		go func() { closed <- iterator.Close(iter) }()
+
+		select {
+		case <-closed:
+			assert.True(t, false, "closed while a pull was still blocked")
+		case <-time.After(10 * time.Millisecond):
+		}
+
+		close(ch)
+		assert.NoError(t, <-closed)
+		assert.False(t, iter.HasNext())
+	})
+
+	t.Run("panics on fewer than 1 worker", func(t *testing.T) {
+		assert.PanicsWith(t, func() { iterator.ParMap(iterator.From(1), 0, square) }, "workers must be greater than 0")
+	})
+}
+
+func TestParFilter(t *testing.T) {
+	even := func(i int) bool { return i%2 == 0 }
+
+	t.Run("filters nothing from an empty iterator", func(t *testing.T) {
+		assert.False(t, iterator.ParFilter(iterator.From[int](), 4, even).HasNext())
+	})
+
+	t.Run("filters items in order", func(t *testing.T) {
+		assert.DeepEqual(t, iterator.ParFilter(iterator.From(1, 2, 3, 4, 5, 6), 3, even).Collect(), []int{2, 4, 6})
+		assert.DeepEqual(t, iterator.ParFilter(iterator.From(1, 3), 3, even).Collect(), []int{})
+	})
+
+	t.Run("filters items out of order when told to", func(t *testing.T) {
+		filtered := iterator.ParFilter(iterator.From(1, 2, 3, 4, 5, 6), 3, even, iterator.Unordered()).Collect()
+		sort.Ints(filtered)
+		assert.DeepEqual(t, filtered, []int{2, 4, 6})
+	})
+
+	t.Run("raises panics again", func(t *testing.T) {
+		iter := iterator.ParFilter(iterator.From(1, 2), 2, func(i int) bool { panic("boom") })
+		assert.PanicsWith(t, func() { iter.HasNext() }, "boom")
+	})
+}
@@ -0,0 +1,151 @@
+package iterator
+
+import (
+	"errors"
+	"math"
+
+	"github.com/gtramontina/go-extlib/maybe"
+)
+
+// ErrNoCheckpoint is what Rewind panics with when there is no checkpoint to
+// rewind to.
+var ErrNoCheckpoint = errors.New("no checkpoint to rewind to")
+
+// Peekable is an Iterator able to look ahead at its next items without
+// yielding them, and to go back to an earlier point of the iteration.
+type Peekable[T any] interface {
+	Iterator[T]
+
+	// Peek returns the next item without yielding it, or None when there are
+	// no more items.
+	Peek() maybe.Maybe[T]
+
+	// PeekN returns up to n of the next items without yielding them. Fewer
+	// items are returned when there are not as many left.
+	PeekN(n uint) []T
+
+	// Checkpoint marks the current point of the iteration, replacing any
+	// earlier checkpoint, so that Rewind can go back to it.
+	Checkpoint()
+
+	// Rewind goes back to the last checkpoint, yielding again the items
+	// yielded since. The checkpoint is kept, so that it can be rewound to
+	// again. Panics with ErrNoCheckpoint when there is no checkpoint, or when
+	// it was lost for having yielded more items than the limit since.
+	Rewind()
+}
+
+// NewPeekable returns a Peekable iterator over the given iterator. Items
+// yielded after a checkpoint are kept to be yielded again on Rewind, up to the
+// given limit: once more items than that are yielded, the checkpoint is lost
+// and those items are let go of. Items peeked at are kept until yielded. When
+// the given iterator is Fallible, Err and Close pass through to it.
+func NewPeekable[T any](iter Iterator[T], limit uint) Peekable[T] {
+	return &peekableIterator[T]{iter: iter, limit: toInt(limit), buffer: nil, position: 0, checkpoint: false}
+}
+
+// toInt converts the given count to an int, clamping it to math.MaxInt: no
+// more items than that could ever be held anyway.
+func toInt(n uint) int {
+	return int(min(n, math.MaxInt))
+}
+
+// peekableIterator keeps the items yielded since the checkpoint, if any, along
+// with those peeked at, in buffer; position is the index of the next item.
+type peekableIterator[T any] struct {
+	iter       Iterator[T]
+	limit      int
+	buffer     []T
+	position   int
+	checkpoint bool
+}
+
+func (i *peekableIterator[T]) HasNext() bool {
+	return i.position < len(i.buffer) || i.iter.HasNext()
+}
+
+func (i *peekableIterator[T]) Next() T {
+	if !i.fill(1) {
+		panic(ErrIteratorEmpty)
+	}
+
+	next := i.buffer[i.position]
+	i.position++
+
+	if !i.checkpoint || i.position > i.limit {
+		i.forget()
+	}
+
+	return next
+}
+
+func (i *peekableIterator[T]) Collect() []T {
+	collected := make([]T, 0)
+	for i.HasNext() {
+		collected = append(collected, i.Next())
+	}
+
+	return collected
+}
+
+func (i *peekableIterator[T]) Peek() maybe.Maybe[T] {
+	if !i.fill(1) {
+		return maybe.None[T]()
+	}
+
+	return maybe.Some(i.buffer[i.position])
+}
+
+func (i *peekableIterator[T]) PeekN(n uint) []T {
+	count := toInt(n)
+	i.fill(count)
+
+	ahead := i.buffer[i.position:]
+	peeked := make([]T, 0, min(count, len(ahead)))
+
+	return append(peeked, ahead[:cap(peeked)]...)
+}
+
+func (i *peekableIterator[T]) Checkpoint() {
+	i.forget()
+	i.checkpoint = true
+}
+
+func (i *peekableIterator[T]) Rewind() {
+	if !i.checkpoint {
+		panic(ErrNoCheckpoint)
+	}
+
+	i.position = 0
+}
+
+func (i *peekableIterator[T]) Err() error {
+	return Err(i.iter)
+}
+
+func (i *peekableIterator[T]) Close() error {
+	return Close(i.iter)
+}
+
+// fill pulls items into the buffer until there are n of them ahead of the
+// current position, returning whether there are.
+func (i *peekableIterator[T]) fill(n int) bool {
+	for len(i.buffer)-i.position < n && i.iter.HasNext() {
+		i.buffer = append(i.buffer, i.iter.Next())
+	}
+
+	return len(i.buffer)-i.position >= n
+}
+
+// forget lets go of the items already yielded, along with the checkpoint.
+func (i *peekableIterator[T]) forget() {
+	var zero T
+
+	for index := 0; index < i.position; index++ {
+		i.buffer[index] = zero
+	}
+
+	i.buffer = i.buffer[i.position:]
+	i.position = 0
+	i.checkpoint = false
+}
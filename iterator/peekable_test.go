@@ -0,0 +1,104 @@
+package iterator_test
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/gtramontina/go-extlib/iterator"
+	"github.com/gtramontina/go-extlib/maybe"
+	"github.com/gtramontina/go-extlib/testing/assert"
+)
+
+func TestPeekable(t *testing.T) {
+	t.Run("peeks at nothing in an empty iterator", func(t *testing.T) {
+		iter := iterator.NewPeekable(iterator.From[int](), 0)
+		assert.False(t, iter.HasNext())
+		assert.DeepEqual(t, iter.Peek(), maybe.None[int]())
+		assert.DeepEqual(t, iter.PeekN(3), []int{})
+		assert.PanicsWith(t, func() { iter.Next() }, iterator.ErrIteratorEmpty)
+	})
+
+	t.Run("peeks at the next item without yielding it", func(t *testing.T) {
+		iter := iterator.NewPeekable(iterator.From(1, 2), 0)
+		assert.DeepEqual(t, iter.Peek(), maybe.Some(1))
+		assert.DeepEqual(t, iter.Peek(), maybe.Some(1))
+		assert.Eq(t, iter.Next(), 1)
+		assert.DeepEqual(t, iter.Peek(), maybe.Some(2))
+		assert.DeepEqual(t, iter.Collect(), []int{2})
+		assert.DeepEqual(t, iter.Peek(), maybe.None[int]())
+	})
+
+	t.Run("peeks at up to n items", func(t *testing.T) {
+		source := &counting{pulled: 0}
+		iter := iterator.NewPeekable[int](source, 0)
+		assert.DeepEqual(t, iter.PeekN(0), []int{})
+		assert.DeepEqual(t, iter.PeekN(3), []int{0, 1, 2})
+		assert.Eq(t, source.pulled, 3)
+		assert.Eq(t, iter.Next(), 0)
+		assert.DeepEqual(t, iter.PeekN(4), []int{1, 2, 3, 4})
+		assert.Eq(t, source.pulled, 5)
+
+		short := iterator.NewPeekable(iterator.From(1, 2), 0)
+		assert.DeepEqual(t, short.PeekN(5), []int{1, 2})
+		assert.DeepEqual(t, short.Collect(), []int{1, 2})
+	})
+
+	t.Run("rewinds to the last checkpoint", func(t *testing.T) {
+		iter := iterator.NewPeekable(iterator.From(1, 2, 3, 4, 5), 10)
+		assert.Eq(t, iter.Next(), 1)
+		iter.Checkpoint()
+		assert.Eq(t, iter.Next(), 2)
+		assert.Eq(t, iter.Next(), 3)
+		iter.Rewind()
+		assert.DeepEqual(t, iter.Peek(), maybe.Some(2))
+		assert.DeepEqual(t, iter.Collect(), []int{2, 3, 4, 5})
+		iter.Rewind()
+		assert.Eq(t, iter.Next(), 2)
+		iter.Checkpoint()
+		assert.DeepEqual(t, iter.Collect(), []int{3, 4, 5})
+		iter.Rewind()
+		assert.DeepEqual(t, iter.Collect(), []int{3, 4, 5})
+	})
+
+	t.Run("loses the checkpoint once more items than the limit are yielded", func(t *testing.T) {
+		iter := iterator.NewPeekable[int](&counting{pulled: 0}, 2)
+		iter.Checkpoint()
+		assert.DeepEqual(t, iter.PeekN(5), []int{0, 1, 2, 3, 4})
+		assert.Eq(t, iter.Next(), 0)
+		assert.Eq(t, iter.Next(), 1)
+		iter.Rewind()
+		assert.Eq(t, iter.Next(), 0)
+		assert.Eq(t, iter.Next(), 1)
+		assert.Eq(t, iter.Next(), 2)
+		assert.PanicsWith(t, func() { iter.Rewind() }, iterator.ErrNoCheckpoint)
+		assert.Eq(t, iter.Next(), 3)
+	})
+
+	t.Run("takes counts too large for an int", func(t *testing.T) {
+		iter := iterator.NewPeekable(iterator.From(1, 2, 3), math.MaxUint)
+		assert.Eq(t, iter.Next(), 1)
+		assert.DeepEqual(t, iter.PeekN(math.MaxUint), []int{2, 3})
+		assert.DeepEqual(t, iter.PeekN(1<<40), []int{2, 3})
+		iter.Checkpoint()
+		assert.DeepEqual(t, iter.Collect(), []int{2, 3})
+		iter.Rewind()
+		assert.DeepEqual(t, iter.Collect(), []int{2, 3})
+		assert.DeepEqual(t, iter.PeekN(math.MaxUint), []int{})
+	})
+
+	t.Run("panics rewinding without a checkpoint", func(t *testing.T) {
+		iter := iterator.NewPeekable(iterator.From(1, 2), 2)
+		assert.PanicsWith(t, func() { iter.Rewind() }, iterator.ErrNoCheckpoint)
+	})
+
+	t.Run("passes errors through and closes the iterator", func(t *testing.T) {
+		source := &rows{read: 0, failAt: 1, total: 3, closed: 0}
+		iter := iterator.NewPeekable[int](source.iterator(context.Background()), 0)
+		assert.DeepEqual(t, iter.PeekN(3), []int{1})
+		assert.True(t, errors.Is(iterator.Err[int](iter), errRead))
+		assert.NoError(t, iterator.Close[int](iter))
+		assert.Eq(t, source.closed, 1)
+	})
+}
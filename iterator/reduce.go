@@ -0,0 +1,19 @@
+package iterator
+
+import "github.com/gtramontina/go-extlib/maybe"
+
+// Reduce reduces the remaining items of the given iterator to a single value
+// by combining them, in order, using the given function. It returns None when
+// the iterator is empty, or Some holding the reduced value otherwise. It
+// consumes the iterator, so it never returns when the iterator is endless. See
+// also: Fold. Example:
+//
+//	subtract := func (a, b int) int { return a - b }
+//	_ = Reduce(From(1, 2, 3, 4), subtract) == maybe.Some(((1 - 2) - 3) - 4)
+func Reduce[T any](iter Iterator[T], reducer func(T, T) T) maybe.Maybe[T] {
+	if !iter.HasNext() {
+		return maybe.None[T]()
+	}
+
+	return maybe.Some(Fold(iter, reducer, iter.Next()))
+}
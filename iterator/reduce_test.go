@@ -0,0 +1,22 @@
+package iterator_test
+
+import (
+	"testing"
+
+	"github.com/gtramontina/go-extlib/iterator"
+	"github.com/gtramontina/go-extlib/maybe"
+	"github.com/gtramontina/go-extlib/testing/assert"
+)
+
+func TestReduce(t *testing.T) {
+	subtract := func(a, b int) int { return a - b }
+
+	t.Run("reduces an empty iterator into nothing", func(t *testing.T) {
+		assert.Eq(t, iterator.Reduce(iterator.From[int](), subtract), maybe.None[int]())
+	})
+
+	t.Run("reduces items in order", func(t *testing.T) {
+		assert.Eq(t, iterator.Reduce(iterator.From(7), subtract), maybe.Some(7))
+		assert.Eq(t, iterator.Reduce(iterator.From(1, 2, 3, 4), subtract), maybe.Some(((1-2)-3)-4))
+	})
+}
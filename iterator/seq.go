@@ -0,0 +1,127 @@
+package iterator
+
+import (
+	"iter"
+
+	"github.com/gtramontina/go-extlib/tuple"
+)
+
+// Seq returns an iter.Seq yielding the remaining items of the given iterator,
+// for use with range-over-func loops and the standard library. Items are only
+// pulled from the iterator as the sequence is ranged over, and breaking out of
+// the loop leaves the rest of them in the iterator. See also: FromSeq.
+func Seq[T any](it Iterator[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for it.HasNext() {
+			if !yield(it.Next()) {
+				return
+			}
+		}
+	}
+}
+
+// Seq2 returns an iter.Seq2 yielding the remaining items of the given iterator
+// along with their index, starting at 0, just like Enumerate does. See also:
+// Seq, FromSeq2.
+func Seq2[T any](it Iterator[T]) iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for index := 0; it.HasNext(); index++ {
+			if !yield(index, it.Next()) {
+				return
+			}
+		}
+	}
+}
+
+// FromSeq returns an iterator pulling the items of the given iter.Seq, one at
+// a time. The sequence is only started once the iterator is first consulted,
+// and stopped once it is exhausted. An iterator left unexhausted must be
+// closed, through Close, to stop the sequence and release whatever it holds on
+// to. See also: Seq.
+func FromSeq[T any](seq iter.Seq[T]) Iterator[T] {
+	return &seqIterator[T]{seq: seq, next: nil, stop: nil, peeked: nil, done: false}
+}
+
+// FromSeq2 returns an iterator pulling the pairs of the given iter.Seq2, one
+// at a time, as tuples. It behaves just like FromSeq otherwise.
+func FromSeq2[A any, B any](seq iter.Seq2[A, B]) Iterator[tuple.OfTwo[A, B]] {
+	return FromSeq(func(yield func(tuple.OfTwo[A, B]) bool) {
+		for a, b := range seq {
+			if !yield(tuple.Of2(a, b)) {
+				return
+			}
+		}
+	})
+}
+
+type seqIterator[T any] struct {
+	seq    iter.Seq[T]
+	next   func() (T, bool)
+	stop   func()
+	peeked *T
+	done   bool
+}
+
+func (i *seqIterator[T]) HasNext() bool {
+	if i.peeked != nil {
+		return true
+	}
+
+	if i.done {
+		return false
+	}
+
+	if i.next == nil {
+		i.next, i.stop = iter.Pull(i.seq)
+	}
+
+	next, ok := i.next()
+	if !ok {
+		i.done = true
+		i.stop()
+
+		return false
+	}
+
+	i.peeked = &next
+
+	return true
+}
+
+func (i *seqIterator[T]) Next() T {
+	if !i.HasNext() {
+		panic(ErrIteratorEmpty)
+	}
+
+	next := *i.peeked
+	i.peeked = nil
+
+	return next
+}
+
+func (i *seqIterator[T]) Collect() []T {
+	collected := make([]T, 0)
+	for i.HasNext() {
+		collected = append(collected, i.Next())
+	}
+
+	return collected
+}
+
+// Close stops the sequence, unless already exhausted, running its deferred
+// calls. The iterator yields nothing afterwards.
+func (i *seqIterator[T]) Close() error {
+	i.peeked = nil
+
+	if i.done {
+		return nil
+	}
+
+	i.done = true
+
+	if i.stop != nil {
+		i.stop()
+	}
+
+	return nil
+}
@@ -0,0 +1,114 @@
+package iterator_test
+
+import (
+	"iter"
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/gtramontina/go-extlib/iterator"
+	"github.com/gtramontina/go-extlib/testing/assert"
+	"github.com/gtramontina/go-extlib/tuple"
+)
+
+func TestSeq(t *testing.T) {
+	t.Run("ranges over the remaining items", func(t *testing.T) {
+		iter := iterator.From(1, 2, 3)
+		iter.Next()
+
+		var ranged []int
+		for item := range iterator.Seq(iter) {
+			ranged = append(ranged, item)
+		}
+
+		assert.DeepEqual(t, ranged, []int{2, 3})
+		assert.False(t, iter.HasNext())
+	})
+
+	t.Run("leaves the items after a break in the iterator", func(t *testing.T) {
+		iter := iterator.From(1, 2, 3, 4)
+		for item := range iterator.Seq(iter) {
+			if item == 2 {
+				break
+			}
+		}
+
+		assert.DeepEqual(t, iter.Collect(), []int{3, 4})
+	})
+
+	t.Run("works with the standard library", func(t *testing.T) {
+		assert.DeepEqual(t, slices.Collect(iterator.Seq(iterator.From(3, 1, 2))), []int{3, 1, 2})
+		assert.DeepEqual(t, slices.Sorted(iterator.Seq(iterator.From(3, 1, 2))), []int{1, 2, 3})
+		assert.DeepEqual(t, slices.Collect(iterator.Seq(iterator.Take(iterator.Cycle(1, 2), 3))), []int{1, 2, 1})
+	})
+}
+
+func TestSeq2(t *testing.T) {
+	indexed := maps.Collect(iterator.Seq2(iterator.From("a", "b")))
+	assert.DeepEqual(t, indexed, map[int]string{0: "a", 1: "b"})
+
+	for index, item := range iterator.Seq2(iterator.From("a", "b", "c")) {
+		assert.Eq(t, item, string(rune('a'+index)))
+	}
+}
+
+func TestFromSeq(t *testing.T) {
+	t.Run("pulls the items of a sequence", func(t *testing.T) {
+		iter := iterator.FromSeq(slices.Values([]int{1, 2, 3}))
+		assert.True(t, iter.HasNext())
+		assert.True(t, iter.HasNext())
+		assert.Eq(t, iter.Next(), 1)
+		assert.DeepEqual(t, iter.Collect(), []int{2, 3})
+		assert.False(t, iter.HasNext())
+		assert.PanicsWith(t, func() { iter.Next() }, iterator.ErrIteratorEmpty)
+	})
+
+	t.Run("starts the sequence lazily and pulls one item at a time", func(t *testing.T) {
+		pulled := 0
+		naturals := iter.Seq[int](func(yield func(int) bool) {
+			for i := 0; ; i++ {
+				pulled++
+				if !yield(i) {
+					return
+				}
+			}
+		})
+
+		it := iterator.FromSeq(naturals)
+		assert.Eq(t, pulled, 0)
+		assert.DeepEqual(t, iterator.Take(it, 3).Collect(), []int{0, 1, 2})
+		assert.Eq(t, pulled, 3)
+	})
+
+	t.Run("stops the sequence once closed early", func(t *testing.T) {
+		stopped := 0
+		naturals := iter.Seq[int](func(yield func(int) bool) {
+			defer func() { stopped++ }()
+
+			for i := 0; yield(i); i++ { //nolint:revive // yielding until told to stop
+			}
+		})
+
+		it := iterator.FromSeq(naturals)
+		assert.NoError(t, iterator.Close(it))
+		assert.Eq(t, stopped, 0)
+
+		it = iterator.FromSeq(naturals)
+		assert.Eq(t, it.Next(), 0)
+		assert.True(t, it.HasNext())
+		assert.NoError(t, iterator.Close(it))
+		assert.Eq(t, stopped, 1)
+		assert.False(t, it.HasNext())
+		assert.NoError(t, iterator.Close(it))
+		assert.Eq(t, stopped, 1)
+	})
+
+	t.Run("round-trips with Seq", func(t *testing.T) {
+		assert.DeepEqual(t, iterator.FromSeq(iterator.Seq(iterator.From(1, 2))).Collect(), []int{1, 2})
+	})
+}
+
+func TestFromSeq2(t *testing.T) {
+	iter := iterator.FromSeq2(slices.All([]string{"a", "b"}))
+	assert.DeepEqual(t, iter.Collect(), []tuple.OfTwo[int, string]{tuple.Of2(0, "a"), tuple.Of2(1, "b")})
+}
@@ -1,6 +1,7 @@
 package iterator_test
 
 import (
+	"sync"
 	"testing"
 
 	"github.com/gtramontina/go-extlib/iterator"
@@ -45,4 +46,38 @@ func TestSplit(t *testing.T) {
 		assert.DeepEqual(t, odds.Next(), 5)
 		assert.False(t, odds.HasNext())
 	})
+
+	t.Run("can be consumed from different goroutines", func(t *testing.T) {
+		source := make([]int, 1000)
+		for i := range source {
+			source[i] = i
+		}
+
+		even, odd := iterator.Split(iterator.FromSlice(source), func(item int) bool { return item%2 == 0 })
+
+		var wg sync.WaitGroup
+
+		var evens, odds []int
+
+		wg.Add(2)
+
+		go func() {
+			defer wg.Done()
+
+			evens = even.Collect()
+		}()
+
+		go func() {
+			defer wg.Done()
+
+			odds = odd.Collect()
+		}()
+
+		wg.Wait()
+
+		assert.Eq(t, len(evens), 500)
+		assert.Eq(t, len(odds), 500)
+		assert.Eq(t, evens[499], 998)
+		assert.Eq(t, odds[499], 999)
+	})
 }
@@ -0,0 +1,60 @@
+package iterator
+
+// StepBy returns an iterator yielding the first item of the given iterator and
+// then every step-th item after it, skipping those in between. The given step
+// must be greater than zero, otherwise it panics.
+func StepBy[T any](iter Iterator[T], step int) Iterator[T] {
+	if step < 1 {
+		panic("step must be greater than 0")
+	}
+
+	return &stepByIterator[T]{iter: iter, step: step, pending: 0}
+}
+
+type stepByIterator[T any] struct {
+	iter    Iterator[T]
+	step    int
+	pending int
+}
+
+func (i *stepByIterator[T]) HasNext() bool {
+	i.skip()
+
+	return i.iter.HasNext()
+}
+
+func (i *stepByIterator[T]) Next() T {
+	i.skip()
+
+	next := i.iter.Next()
+	i.pending = i.step - 1
+
+	return next
+}
+
+func (i *stepByIterator[T]) Collect() []T {
+	collected := make([]T, 0)
+	for i.HasNext() {
+		collected = append(collected, i.Next())
+	}
+
+	return collected
+}
+
+// skip skips the items between the one last yielded and the next one due. It
+// only happens once the next one is asked for.
+func (i *stepByIterator[T]) skip() {
+	for ; i.pending > 0 && i.iter.HasNext(); i.pending-- {
+		i.iter.Next()
+	}
+
+	i.pending = 0
+}
+
+func (i *stepByIterator[T]) Err() error {
+	return Err(i.iter)
+}
+
+func (i *stepByIterator[T]) Close() error {
+	return Close(i.iter)
+}
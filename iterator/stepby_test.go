@@ -0,0 +1,38 @@
+package iterator_test
+
+import (
+	"testing"
+
+	"github.com/gtramontina/go-extlib/iterator"
+	"github.com/gtramontina/go-extlib/testing/assert"
+)
+
+func TestStepBy(t *testing.T) {
+	t.Run("steps over nothing in an empty iterator", func(t *testing.T) {
+		iter := iterator.StepBy(iterator.From[int](), 2)
+		assert.False(t, iter.HasNext())
+		assert.PanicsWith(t, func() { iter.Next() }, iterator.ErrIteratorEmpty)
+	})
+
+	t.Run("yields the first item and every step-th after it", func(t *testing.T) {
+		assert.DeepEqual(t, iterator.StepBy(iterator.From(0, 1, 2, 3, 4, 5, 6), 1).Collect(), []int{0, 1, 2, 3, 4, 5, 6})
+		assert.DeepEqual(t, iterator.StepBy(iterator.From(0, 1, 2, 3, 4, 5, 6), 3).Collect(), []int{0, 3, 6})
+		assert.DeepEqual(t, iterator.StepBy(iterator.From(0, 1, 2, 3, 4, 5), 3).Collect(), []int{0, 3})
+		assert.DeepEqual(t, iterator.StepBy(iterator.From(0, 1, 2), 9).Collect(), []int{0})
+	})
+
+	t.Run("skips lazily", func(t *testing.T) {
+		source := &counting{pulled: 0}
+		iter := iterator.StepBy[int](source, 10)
+		assert.Eq(t, iter.Next(), 0)
+		assert.Eq(t, source.pulled, 1)
+		assert.True(t, iter.HasNext())
+		assert.True(t, iter.HasNext())
+		assert.Eq(t, source.pulled, 10)
+		assert.Eq(t, iter.Next(), 10)
+	})
+
+	t.Run("panics on steps smaller than 1", func(t *testing.T) {
+		assert.PanicsWith(t, func() { iterator.StepBy(iterator.From(1), 0) }, "step must be greater than 0")
+	})
+}
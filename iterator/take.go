@@ -0,0 +1,45 @@
+package iterator
+
+// Take returns an iterator yielding up to the first n items of the given
+// iterator. Once n items are yielded, the given iterator is no longer
+// consulted, which makes Take suitable for bounding endless iterators, such
+// as those returned by Cycle. See also: TakeWhile, Drop.
+func Take[T any](iter Iterator[T], n uint) Iterator[T] {
+	return &takeIterator[T]{iter: iter, remaining: n}
+}
+
+type takeIterator[T any] struct {
+	iter      Iterator[T]
+	remaining uint
+}
+
+func (i *takeIterator[T]) HasNext() bool {
+	return i.remaining > 0 && i.iter.HasNext()
+}
+
+func (i *takeIterator[T]) Next() T {
+	if i.remaining == 0 {
+		panic(ErrIteratorEmpty)
+	}
+
+	i.remaining--
+
+	return i.iter.Next()
+}
+
+func (i *takeIterator[T]) Collect() []T {
+	collected := make([]T, 0)
+	for i.HasNext() {
+		collected = append(collected, i.Next())
+	}
+
+	return collected
+}
+
+func (i *takeIterator[T]) Err() error {
+	return Err(i.iter)
+}
+
+func (i *takeIterator[T]) Close() error {
+	return Close(i.iter)
+}
@@ -0,0 +1,65 @@
+package iterator_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gtramontina/go-extlib/iterator"
+	"github.com/gtramontina/go-extlib/testing/assert"
+)
+
+// counting is an endless iterator over the natural numbers, counting how many
+// of them were pulled.
+type counting struct{ pulled int }
+
+func (c *counting) HasNext() bool { return true }
+
+func (c *counting) Next() int {
+	c.pulled++
+
+	return c.pulled - 1
+}
+
+func (c *counting) Collect() []int { panic("endless") }
+
+func TestTake(t *testing.T) {
+	t.Run("takes nothing from an empty iterator", func(t *testing.T) {
+		iter := iterator.Take(iterator.From[int](), 2)
+		assert.False(t, iter.HasNext())
+		assert.PanicsWith(t, func() { iter.Next() }, iterator.ErrIteratorEmpty)
+	})
+
+	t.Run("takes up to the given number of items", func(t *testing.T) {
+		assert.DeepEqual(t, iterator.Take(iterator.From(1, 2, 3), 0).Collect(), []int{})
+		assert.DeepEqual(t, iterator.Take(iterator.From(1, 2, 3), 2).Collect(), []int{1, 2})
+		assert.DeepEqual(t, iterator.Take(iterator.From(1, 2, 3), 5).Collect(), []int{1, 2, 3})
+	})
+
+	t.Run("bounds endless iterators", func(t *testing.T) {
+		assert.DeepEqual(t, iterator.Take(iterator.Cycle(1, 2), 5).Collect(), []int{1, 2, 1, 2, 1})
+
+		source := &counting{pulled: 0}
+		iter := iterator.Take[int](source, 3)
+		assert.True(t, iter.HasNext())
+		assert.Eq(t, source.pulled, 0)
+		assert.DeepEqual(t, iter.Collect(), []int{0, 1, 2})
+		assert.Eq(t, source.pulled, 3)
+		assert.False(t, iter.HasNext())
+		assert.PanicsWith(t, func() { iter.Next() }, iterator.ErrIteratorEmpty)
+	})
+
+	t.Run("passes errors through and closes the iterator", func(t *testing.T) {
+		failing := &rows{read: 0, failAt: 2, total: 100, closed: 0}
+		iter := iterator.Take[int](failing.iterator(context.Background()), 10)
+		assert.DeepEqual(t, iter.Collect(), []int{1, 2})
+		assert.True(t, errors.Is(iterator.Err(iter), errRead))
+
+		endless := &rows{read: 0, failAt: -1, total: -1, closed: 0}
+		iter = iterator.Take[int](endless.iterator(context.Background()), 10)
+		assert.Eq(t, iter.Next(), 1)
+		assert.NoError(t, iterator.Err(iter))
+		assert.NoError(t, iterator.Close(iter))
+		assert.Eq(t, endless.closed, 1)
+	})
+}
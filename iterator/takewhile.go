@@ -0,0 +1,65 @@
+package iterator
+
+// TakeWhile returns an iterator yielding the items of the given iterator for
+// as long as they satisfy the given predicate. The first item failing it ends
+// the iteration; it is consumed from the given iterator but never yielded.
+// See also: Take, DropWhile.
+func TakeWhile[T any](iter Iterator[T], predicate func(T) bool) Iterator[T] {
+	return &takeWhileIterator[T]{iter: iter, predicate: predicate, next: nil, done: false}
+}
+
+type takeWhileIterator[T any] struct {
+	iter      Iterator[T]
+	predicate func(T) bool
+	next      *T
+	done      bool
+}
+
+func (i *takeWhileIterator[T]) HasNext() bool {
+	if i.next != nil {
+		return true
+	}
+
+	if i.done || !i.iter.HasNext() {
+		return false
+	}
+
+	next := i.iter.Next()
+	if !i.predicate(next) {
+		i.done = true
+
+		return false
+	}
+
+	i.next = &next
+
+	return true
+}
+
+func (i *takeWhileIterator[T]) Next() T {
+	if !i.HasNext() {
+		panic(ErrIteratorEmpty)
+	}
+
+	next := *i.next
+	i.next = nil
+
+	return next
+}
+
+func (i *takeWhileIterator[T]) Collect() []T {
+	collected := make([]T, 0)
+	for i.HasNext() {
+		collected = append(collected, i.Next())
+	}
+
+	return collected
+}
+
+func (i *takeWhileIterator[T]) Err() error {
+	return Err(i.iter)
+}
+
+func (i *takeWhileIterator[T]) Close() error {
+	return Close(i.iter)
+}
@@ -0,0 +1,36 @@
+package iterator_test
+
+import (
+	"testing"
+
+	"github.com/gtramontina/go-extlib/iterator"
+	"github.com/gtramontina/go-extlib/testing/assert"
+)
+
+func TestTakeWhile(t *testing.T) {
+	below := func(n int) func(int) bool { return func(i int) bool { return i < n } }
+
+	t.Run("takes nothing from an empty iterator", func(t *testing.T) {
+		iter := iterator.TakeWhile(iterator.From[int](), below(3))
+		assert.False(t, iter.HasNext())
+		assert.PanicsWith(t, func() { iter.Next() }, iterator.ErrIteratorEmpty)
+	})
+
+	t.Run("takes items up to the first one failing the predicate", func(t *testing.T) {
+		assert.DeepEqual(t, iterator.TakeWhile(iterator.From(1, 2, 3, 1), below(3)).Collect(), []int{1, 2})
+		assert.DeepEqual(t, iterator.TakeWhile(iterator.From(1, 2, 3, 1), below(9)).Collect(), []int{1, 2, 3, 1})
+		assert.DeepEqual(t, iterator.TakeWhile(iterator.From(1, 2, 3, 1), below(0)).Collect(), []int{})
+	})
+
+	t.Run("pulls no more items than needed", func(t *testing.T) {
+		source := &counting{pulled: 0}
+		iter := iterator.TakeWhile[int](source, below(2))
+		assert.True(t, iter.HasNext())
+		assert.True(t, iter.HasNext())
+		assert.Eq(t, source.pulled, 1)
+		assert.DeepEqual(t, iter.Collect(), []int{0, 1})
+		assert.Eq(t, source.pulled, 3)
+		assert.False(t, iter.HasNext())
+		assert.Eq(t, source.pulled, 3)
+	})
+}
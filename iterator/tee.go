@@ -3,61 +3,198 @@ package iterator
 import "sync"
 
 // Tee returns two iterators that iterate over the same underlying iterator.
-// Both iterators can consume the items independently.
+// Both iterators can consume the items independently. When the underlying
+// iterator is Fallible, both report its Err, and it is only closed once both
+// are closed. See also: Broadcast.
 func Tee[T any](it Iterator[T]) (Iterator[T], Iterator[T]) {
-	buffer := &teeBuffer[T]{}
+	iters := Broadcast(it, 2)
 
-	return &teeIterator[T]{it: it, buffer: buffer}, &teeIterator[T]{it: it, buffer: buffer}
+	return iters[0], iters[1]
 }
 
+// Broadcast returns n iterators that iterate over the same underlying
+// iterator, each yielding all of its items independently of the others. Items
+// are pulled from the underlying iterator once, as the iterator furthest ahead
+// asks for them, and kept only until all of those still open have yielded
+// them. Each of the returned iterators may be consumed from a goroutine of its
+// own.
+//
+// When the underlying iterator is Fallible, all of them report its Err, and it
+// is only closed once all of them are closed. Panics when n is smaller than 1.
+// See also: Tee.
+func Broadcast[T any](it Iterator[T], n int) []Iterator[T] {
+	if n < 1 {
+		panic("n must be greater than 0")
+	}
+
+	buffer := &teeBuffer[T]{
+		Mutex:     sync.Mutex{},
+		pull:      sync.Mutex{},
+		source:    it,
+		items:     nil,
+		head:      0,
+		count:     0,
+		offset:    0,
+		positions: make([]int, n),
+		open:      n,
+	}
+
+	iters := make([]Iterator[T], 0, n)
+	for branch := 0; branch < n; branch++ {
+		iters = append(iters, &teeIterator[T]{buffer: buffer, branch: branch, closed: false})
+	}
+
+	return iters
+}
+
+// teeBuffer holds the underlying iterator along with the items pulled from it
+// but not yet yielded by every open branch, guarding both from concurrent
+// access. Items are kept in a ring, growing only when the branches drift
+// further apart than it can hold.
+//
+// The embedded mutex guards the buffered items, and pull guards the underlying
+// iterator, so that a branch blocked pulling from it holds back no branch
+// behind, with items already buffered. When both are held, pull comes first.
 type teeBuffer[T any] struct {
-	sync.RWMutex
-	buffer []T
-	zero   T
+	sync.Mutex
+	pull   sync.Mutex
+	source Iterator[T]
+
+	// items is the ring holding count items, the oldest at head.
+	items []T
+	head  int
+	count int
+
+	// offset is the position, within the underlying iterator, of the item at
+	// head; positions holds the position of the next item of each branch, or
+	// -1 once the branch is closed.
+	offset    int
+	positions []int
+	open      int
+}
+
+// Has tells whether there is a next item for the given branch, pulling it from
+// the underlying iterator when not yet pulled.
+func (b *teeBuffer[T]) Has(branch int) bool {
+	return b.buffered(branch) || b.fill(branch)
+}
+
+// Get returns the next item for the given branch, pulling it from the
+// underlying iterator when not yet pulled, and dropping it once all open
+// branches have had it.
+func (b *teeBuffer[T]) Get(branch int) T {
+	if !b.Has(branch) {
+		panic(ErrIteratorEmpty)
+	}
+
+	b.Lock()
+	defer b.Unlock()
+
+	position := b.positions[branch]
+	item := b.items[(b.head+position-b.offset)%len(b.items)]
+	b.positions[branch]++
+	b.trim()
+
+	return item
 }
 
-func (b *teeBuffer[T]) Append(item T) {
+// buffered tells whether the next item for the given branch is already pulled.
+func (b *teeBuffer[T]) buffered(branch int) bool {
 	b.Lock()
 	defer b.Unlock()
 
-	b.buffer = append(b.buffer, item)
+	return b.positions[branch] < b.offset+b.count
 }
 
-func (b *teeBuffer[T]) Get(index int) (T, bool) {
-	b.RLock()
-	defer b.RUnlock()
+// fill pulls the next item for the given branch from the underlying iterator,
+// unless another branch did so in the meantime, telling whether there was one.
+// The buffered items are only locked once the item is pulled.
+func (b *teeBuffer[T]) fill(branch int) bool {
+	b.pull.Lock()
+	defer b.pull.Unlock()
+
+	if b.buffered(branch) {
+		return true
+	}
 
-	if index < len(b.buffer) {
-		return b.buffer[index], true
+	if !b.source.HasNext() {
+		return false
 	}
 
-	return b.zero, false
+	item := b.source.Next()
+
+	b.Lock()
+	defer b.Unlock()
+
+	b.push(item)
+
+	return true
 }
 
-type teeIterator[T any] struct {
-	it     Iterator[T]
-	index  int
-	buffer *teeBuffer[T]
+// Release closes the given branch, returning whether it was the last one open.
+func (b *teeBuffer[T]) Release(branch int) bool {
+	b.Lock()
+	defer b.Unlock()
+
+	b.positions[branch] = -1
+	b.open--
+	b.trim()
+
+	return b.open == 0
 }
 
-func (i *teeIterator[T]) HasNext() bool {
-	if i.index < len(i.buffer.buffer) {
-		return true
+// push appends the given item to the ring, growing it when full.
+func (b *teeBuffer[T]) push(item T) {
+	if b.count == len(b.items) {
+		grown := make([]T, max(2*len(b.items), 4))
+		for i := 0; i < b.count; i++ {
+			grown[i] = b.items[(b.head+i)%len(b.items)]
+		}
+
+		b.items, b.head = grown, 0
 	}
 
-	return i.it.HasNext()
+	b.items[(b.head+b.count)%len(b.items)] = item
+	b.count++
 }
 
-func (i *teeIterator[T]) Next() T {
-	if item, ok := i.buffer.Get(i.index); ok {
-		i.index++
+// trim drops the items all open branches are past, zeroing them so they can
+// be garbage collected.
+func (b *teeBuffer[T]) trim() {
+	behind := b.offset + b.count
 
-		return item
+	for _, position := range b.positions {
+		if position != -1 && position < behind {
+			behind = position
+		}
 	}
 
-	i.buffer.Append(i.it.Next())
+	var zero T
+
+	for b.offset < behind {
+		b.items[b.head] = zero
+		b.head = (b.head + 1) % len(b.items)
+		b.count--
+		b.offset++
+	}
+}
+
+type teeIterator[T any] struct {
+	buffer *teeBuffer[T]
+	branch int
+	closed bool
+}
+
+func (i *teeIterator[T]) HasNext() bool {
+	return !i.closed && i.buffer.Has(i.branch)
+}
+
+func (i *teeIterator[T]) Next() T {
+	if i.closed {
+		panic(ErrIteratorEmpty)
+	}
 
-	return i.Next()
+	return i.buffer.Get(i.branch)
 }
 
 func (i *teeIterator[T]) Collect() []T {
@@ -68,3 +205,27 @@ func (i *teeIterator[T]) Collect() []T {
 
 	return collected
 }
+
+func (i *teeIterator[T]) Err() error {
+	i.buffer.pull.Lock()
+	defer i.buffer.pull.Unlock()
+
+	return Err(i.buffer.source)
+}
+
+func (i *teeIterator[T]) Close() error {
+	if i.closed {
+		return nil
+	}
+
+	i.closed = true
+
+	if !i.buffer.Release(i.branch) {
+		return nil
+	}
+
+	i.buffer.pull.Lock()
+	defer i.buffer.pull.Unlock()
+
+	return Close(i.buffer.source)
+}
@@ -1,7 +1,12 @@
 package iterator_test
 
 import (
+	"context"
+	"runtime"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/gtramontina/go-extlib/iterator"
 	"github.com/gtramontina/go-extlib/testing/assert"
@@ -101,3 +106,157 @@ func TestTee(t *testing.T) {
 		assert.DeepEqual(t, iter4.HasNext(), false)
 	})
 }
+
+func TestBroadcast(t *testing.T) {
+	t.Run("yields all items to each iterator", func(t *testing.T) {
+		iters := iterator.Broadcast(iterator.From(1, 2, 3), 3)
+		assert.Eq(t, len(iters), 3)
+		assert.Eq(t, iters[1].Next(), 1)
+
+		for _, iter := range iters {
+			assert.True(t, iter.HasNext())
+		}
+
+		assert.DeepEqual(t, iters[0].Collect(), []int{1, 2, 3})
+		assert.DeepEqual(t, iters[1].Collect(), []int{2, 3})
+		assert.DeepEqual(t, iters[2].Collect(), []int{1, 2, 3})
+	})
+
+	t.Run("pulls each item once", func(t *testing.T) {
+		source := &counting{pulled: 0}
+		iters := iterator.Broadcast[int](source, 4)
+
+		for _, iter := range iters {
+			assert.DeepEqual(t, iterator.Take(iter, 3).Collect(), []int{0, 1, 2})
+		}
+
+		assert.Eq(t, source.pulled, 3)
+	})
+
+	t.Run("can be consumed from different goroutines", func(t *testing.T) {
+		source := make([]int, 1000)
+		for i := range source {
+			source[i] = i
+		}
+
+		iters := iterator.Broadcast(iterator.FromSlice(source), 8)
+		collected := make([][]int, len(iters))
+
+		var wg sync.WaitGroup
+
+		for i, iter := range iters {
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
+
+				collected[i] = iter.Collect()
+			}()
+		}
+
+		wg.Wait()
+
+		for _, items := range collected {
+			assert.DeepEqual(t, items, source)
+		}
+	})
+
+	t.Run("yields pulled items while another iterator waits for the next", func(t *testing.T) {
+		ch := make(chan int)
+		iters := iterator.Broadcast(iterator.FromChan(ch), 2)
+		ahead := make(chan int)
+
+		go func() {
+			defer close(ahead)
+
+			for iters[0].HasNext() {
+				ahead <- iters[0].Next()
+			}
+		}()
+
+		ch <- 1
+		assert.Eq(t, <-ahead, 1)
+		time.Sleep(10 * time.Millisecond)
+
+		behind := make(chan int, 1)
+
+		go func() { behind <- iters[1].Next() }()
+
+		select {
+		case item := <-behind:
+			assert.Eq(t, item, 1)
+		case <-time.After(time.Second):
+			assert.True(t, false, "held back by the iterator waiting for the next item")
+		}
+
+		close(ch)
+
+		_, open := <-ahead
+		assert.False(t, open)
+		assert.False(t, iters[1].HasNext())
+	})
+
+	t.Run("closes the iterator once all are closed", func(t *testing.T) {
+		source := &rows{read: 0, failAt: -1, total: 5, closed: 0}
+		iters := iterator.Broadcast[int](source.iterator(context.Background()), 3)
+
+		for _, iter := range iters {
+			assert.Eq(t, source.closed, 0)
+			assert.NoError(t, iterator.Close(iter))
+		}
+
+		assert.Eq(t, source.closed, 1)
+	})
+
+	t.Run("lets go of items once all iterators yielded them", func(t *testing.T) {
+		var released atomic.Int64
+
+		items := iterator.Map[int](iterator.Take[int](&counting{pulled: 0}, 1000), func(int) *[16]int {
+			item := new([16]int)
+			runtime.SetFinalizer(item, func(*[16]int) { released.Add(1) })
+
+			return item
+		})
+
+		iters := iterator.Broadcast(items, 3)
+		assert.NoError(t, iterator.Close(iters[2]))
+
+		for iters[0].HasNext() {
+			iters[0].Next()
+			iters[1].Next()
+		}
+
+		for i := 0; i < 100 && released.Load() < 990; i++ {
+			runtime.GC()
+			time.Sleep(time.Millisecond)
+		}
+
+		assert.True(t, released.Load() >= 990)
+		runtime.KeepAlive(iters)
+	})
+
+	t.Run("keeps items for iterators far behind", func(t *testing.T) {
+		iters := iterator.Broadcast[int](&counting{pulled: 0}, 2)
+
+		for n := 0; n < 5; n++ {
+			assert.DeepEqual(t, iterator.Take(iters[0], 100).Collect(), expectedRange(100*n, 100*n+100))
+			assert.DeepEqual(t, iterator.Take(iters[1], 30).Collect(), expectedRange(30*n, 30*n+30))
+		}
+
+		assert.DeepEqual(t, iterator.Take(iters[1], 400).Collect(), expectedRange(150, 550))
+		assert.DeepEqual(t, iterator.Take(iters[0], 50).Collect(), expectedRange(500, 550))
+	})
+
+	t.Run("panics on fewer than 1 iterator", func(t *testing.T) {
+		assert.PanicsWith(t, func() { iterator.Broadcast(iterator.From(1), 0) }, "n must be greater than 0")
+	})
+}
+
+func expectedRange(from int, to int) []int {
+	expected := make([]int, 0, to-from)
+	for i := from; i < to; i++ {
+		expected = append(expected, i)
+	}
+
+	return expected
+}
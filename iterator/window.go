@@ -0,0 +1,65 @@
+package iterator
+
+// Window returns an iterator yielding every run of the given size of
+// consecutive items of the given iterator, sliding one item at a time. An
+// iterator holding fewer items than the given size yields no window. Only the
+// window being built is held in memory, and each yielded window is a slice of
+// its own. The given window size must be greater than zero, otherwise it
+// panics. See also: Chunk.
+func Window[T any](iter Iterator[T], windowSize int) Iterator[[]T] {
+	if windowSize < 1 {
+		panic("window size must be greater than 0")
+	}
+
+	return &windowIterator[T]{iter: iter, windowSize: windowSize, window: nil}
+}
+
+type windowIterator[T any] struct {
+	iter       Iterator[T]
+	windowSize int
+	window     []T
+}
+
+func (i *windowIterator[T]) HasNext() bool {
+	for len(i.window) < i.windowSize-1 && i.iter.HasNext() {
+		i.window = append(i.window, i.iter.Next())
+	}
+
+	return len(i.window) == i.windowSize-1 && i.iter.HasNext()
+}
+
+func (i *windowIterator[T]) Next() []T {
+	if !i.HasNext() {
+		panic(ErrIteratorEmpty)
+	}
+
+	next := i.iter.Next()
+
+	window := make([]T, 0, i.windowSize)
+	window = append(window, i.window...)
+	window = append(window, next)
+
+	if len(i.window) > 0 {
+		copy(i.window, i.window[1:])
+		i.window[len(i.window)-1] = next
+	}
+
+	return window
+}
+
+func (i *windowIterator[T]) Collect() [][]T {
+	collected := make([][]T, 0)
+	for i.HasNext() {
+		collected = append(collected, i.Next())
+	}
+
+	return collected
+}
+
+func (i *windowIterator[T]) Err() error {
+	return Err(i.iter)
+}
+
+func (i *windowIterator[T]) Close() error {
+	return Close(i.iter)
+}
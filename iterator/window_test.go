@@ -0,0 +1,44 @@
+package iterator_test
+
+import (
+	"testing"
+
+	"github.com/gtramontina/go-extlib/iterator"
+	"github.com/gtramontina/go-extlib/testing/assert"
+)
+
+func TestWindow(t *testing.T) {
+	t.Run("yields no window when there are fewer items than its size", func(t *testing.T) {
+		iter := iterator.Window(iterator.From(1, 2), 3)
+		assert.False(t, iter.HasNext())
+		assert.PanicsWith(t, func() { iter.Next() }, iterator.ErrIteratorEmpty)
+		assert.False(t, iterator.Window(iterator.From[int](), 1).HasNext())
+	})
+
+	t.Run("slides windows of the given size one item at a time", func(t *testing.T) {
+		assert.DeepEqual(t, iterator.Window(iterator.From(1, 2, 3, 4), 2).Collect(), [][]int{{1, 2}, {2, 3}, {3, 4}})
+		assert.DeepEqual(t, iterator.Window(iterator.From(1, 2, 3), 3).Collect(), [][]int{{1, 2, 3}})
+		assert.DeepEqual(t, iterator.Window(iterator.From(1, 2, 3), 1).Collect(), [][]int{{1}, {2}, {3}})
+	})
+
+	t.Run("yields windows of their own", func(t *testing.T) {
+		iter := iterator.Window(iterator.From(1, 2, 3, 4), 3)
+		first := iter.Next()
+		first[1], first[2] = 0, 0
+		assert.DeepEqual(t, iter.Next(), []int{2, 3, 4})
+	})
+
+	t.Run("pulls no more items than needed", func(t *testing.T) {
+		source := &counting{pulled: 0}
+		iter := iterator.Window[int](source, 3)
+		assert.True(t, iter.HasNext())
+		assert.Eq(t, source.pulled, 2)
+		assert.DeepEqual(t, iter.Next(), []int{0, 1, 2})
+		assert.DeepEqual(t, iter.Next(), []int{1, 2, 3})
+		assert.Eq(t, source.pulled, 4)
+	})
+
+	t.Run("panics on window sizes smaller than 1", func(t *testing.T) {
+		assert.PanicsWith(t, func() { iterator.Window(iterator.From(1), 0) }, "window size must be greater than 0")
+	})
+}
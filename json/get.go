@@ -0,0 +1,165 @@
+package json
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/gtramontina/go-extlib/maybe"
+	"github.com/gtramontina/go-extlib/result"
+)
+
+// ErrInvalidPath is wrapped by the errors of Get when the given path cannot be
+// parsed.
+var ErrInvalidPath = errors.New("invalid path")
+
+// Get extracts the value found at the given path within the given JSON
+// document, unmarshaling it into the given type with TryUnmarshal.
+//
+// Paths are made of object keys separated by dots and of array indexes within
+// brackets, as in "items[3].price". Keys holding dots or brackets may be given
+// as quoted strings within brackets, as in `prices["1.5"]`. The empty path
+// refers to the whole document.
+//
+// The returned result.Result is Err when the document is malformed, when the
+// path is invalid or when the value found does not unmarshal into the given
+// type. Otherwise, it holds a maybe.Maybe which is None when nothing is found
+// at the path, and Some holding the value found otherwise; null is a value.
+func Get[Out any, In string | []byte](doc In, path string) result.Result[maybe.Maybe[Out]] {
+	segments, err := parsePath(path)
+	if err != nil {
+		return result.Err[maybe.Maybe[Out]](err)
+	}
+
+	current := json.RawMessage(doc)
+	if !json.Valid(current) {
+		_, err = TryUnmarshal[any]([]byte(current))
+
+		return result.Err[maybe.Maybe[Out]](err)
+	}
+
+	for _, segment := range segments {
+		found, ok := segment.lookup(current)
+		if !ok {
+			return result.Ok(maybe.None[Out]())
+		}
+
+		current = found
+	}
+
+	return result.Map(result.Of(TryUnmarshal[Out]([]byte(current))), maybe.Some[Out])
+}
+
+// segment is either a key within an object or an index within an array.
+type segment struct {
+	key     string
+	index   int
+	isIndex bool
+}
+
+func (s segment) lookup(in json.RawMessage) (json.RawMessage, bool) {
+	if s.isIndex {
+		var array []json.RawMessage
+		if json.Unmarshal(in, &array) != nil || s.index >= len(array) {
+			return nil, false
+		}
+
+		return array[s.index], true
+	}
+
+	var object map[string]json.RawMessage
+	if json.Unmarshal(in, &object) != nil {
+		return nil, false
+	}
+
+	found, ok := object[s.key]
+
+	return found, ok
+}
+
+func parsePath(path string) ([]segment, error) {
+	segments := make([]segment, 0)
+	rest := path
+
+	for first := true; rest != ""; first = false {
+		var (
+			next segment
+			err  error
+		)
+
+		switch {
+		case rest[0] == '[':
+			next, rest, err = parseBracket(rest[1:])
+		case rest[0] == '.' && !first:
+			next, rest, err = parseKey(rest[1:])
+		case first:
+			next, rest, err = parseKey(rest)
+		default:
+			err = ErrInvalidPath
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidPath, path)
+		}
+
+		segments = append(segments, next)
+	}
+
+	return segments, nil
+}
+
+func keySegment(key string) segment {
+	return segment{key: key, index: 0, isIndex: false}
+}
+
+func indexSegment(index int) segment {
+	return segment{key: "", index: index, isIndex: true}
+}
+
+// parseKey parses a key running up to the next dot or bracket.
+func parseKey(in string) (segment, string, error) {
+	end := strings.IndexAny(in, ".[")
+	if end == -1 {
+		end = len(in)
+	}
+
+	if end == 0 {
+		return keySegment(""), "", ErrInvalidPath
+	}
+
+	return keySegment(in[:end]), in[end:], nil
+}
+
+// parseBracket parses either an index or a quoted key, up to the closing
+// bracket.
+func parseBracket(in string) (segment, string, error) {
+	if strings.HasPrefix(in, `"`) {
+		var key string
+
+		decoder := json.NewDecoder(strings.NewReader(in))
+		if err := decoder.Decode(&key); err != nil {
+			return keySegment(""), "", ErrInvalidPath
+		}
+
+		in = in[decoder.InputOffset():]
+		if !strings.HasPrefix(in, "]") {
+			return keySegment(""), "", ErrInvalidPath
+		}
+
+		return keySegment(key), in[1:], nil
+	}
+
+	end := strings.IndexByte(in, ']')
+	if end == -1 {
+		return keySegment(""), "", ErrInvalidPath
+	}
+
+	index, err := strconv.Atoi(in[:end])
+	if err != nil || index < 0 || strings.HasPrefix(in, "+") {
+		return keySegment(""), "", ErrInvalidPath
+	}
+
+	return indexSegment(index), in[end+1:], nil
+}
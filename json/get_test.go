@@ -0,0 +1,73 @@
+package json_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/gtramontina/go-extlib/json"
+	"github.com/gtramontina/go-extlib/maybe"
+	"github.com/gtramontina/go-extlib/result"
+	"github.com/gtramontina/go-extlib/testing/assert"
+)
+
+func TestJSONGet(t *testing.T) {
+	doc := `{
+		"id": "order-1",
+		"customer": {"name": "John", "tags": ["vip", "early"]},
+		"items": [
+			{"sku": "a", "price": 1.5},
+			{"sku": "b", "price": 2},
+			{"sku": "c", "price": null}
+		],
+		"prices": {"1.5": "cheap", "a[0]": "odd", "": "empty"}
+	}`
+
+	t.Run("extracts values at the given path", func(t *testing.T) {
+		assert.DeepEqual(t, json.Get[string](doc, "id"), result.Ok(maybe.Some("order-1")))
+		assert.DeepEqual(t, json.Get[string](doc, "customer.name"), result.Ok(maybe.Some("John")))
+		assert.DeepEqual(t, json.Get[string](doc, "customer.tags[1]"), result.Ok(maybe.Some("early")))
+		assert.DeepEqual(t, json.Get[float64](doc, "items[0].price"), result.Ok(maybe.Some(1.5)))
+		assert.DeepEqual(t, json.Get[int]([]byte(doc), "items[1].price"), result.Ok(maybe.Some(2)))
+		assert.DeepEqual(t, json.Get[[]string](doc, "customer.tags").Unwrap().Unwrap(), []string{"vip", "early"})
+		assert.DeepEqual(t, json.Get[Person](`[{"Name":"John","Age":30}]`, "[0]"), result.Ok(maybe.Some(Person{Name: "John", Age: 30})))
+		assert.DeepEqual(t, json.Get[int](`42`, ""), result.Ok(maybe.Some(42)))
+	})
+
+	t.Run("extracts keys given as quoted strings", func(t *testing.T) {
+		assert.DeepEqual(t, json.Get[string](doc, `prices["1.5"]`), result.Ok(maybe.Some("cheap")))
+		assert.DeepEqual(t, json.Get[string](doc, `prices["a[0]"]`), result.Ok(maybe.Some("odd")))
+		assert.DeepEqual(t, json.Get[string](doc, `prices[""]`), result.Ok(maybe.Some("empty")))
+		assert.DeepEqual(t, json.Get[string](doc, `["customer"]["name"]`), result.Ok(maybe.Some("John")))
+	})
+
+	t.Run("extracts null as a value", func(t *testing.T) {
+		assert.DeepEqual(t, json.Get[*float64](doc, "items[2].price"), result.Ok(maybe.Some[*float64](nil)))
+		assert.DeepEqual(t, json.Get[maybe.Maybe[float64]](doc, "items[2].price"), result.Ok(maybe.Some(maybe.None[float64]())))
+	})
+
+	t.Run("finds nothing at missing paths", func(t *testing.T) {
+		assert.DeepEqual(t, json.Get[string](doc, "nope"), result.Ok(maybe.None[string]()))
+		assert.DeepEqual(t, json.Get[string](doc, "customer.nope"), result.Ok(maybe.None[string]()))
+		assert.DeepEqual(t, json.Get[float64](doc, "items[3].price"), result.Ok(maybe.None[float64]()))
+		assert.DeepEqual(t, json.Get[string](doc, "id.nope"), result.Ok(maybe.None[string]()))
+		assert.DeepEqual(t, json.Get[string](doc, "customer[0]"), result.Ok(maybe.None[string]()))
+		assert.DeepEqual(t, json.Get[string](doc, "items.sku"), result.Ok(maybe.None[string]()))
+	})
+
+	t.Run("fails when the value found does not fit the given type", func(t *testing.T) {
+		assert.True(t, json.Get[int](doc, "id").IsErr())
+		assert.True(t, json.Get[int](doc, "items[0].price").IsErr())
+		assert.True(t, json.Get[[]int](doc, "customer.tags").IsErr())
+	})
+
+	t.Run("fails on malformed documents", func(t *testing.T) {
+		assert.True(t, json.Get[int](`{"a":`, "a").IsErr())
+		assert.True(t, json.Get[int](``, "").IsErr())
+	})
+
+	t.Run("fails on invalid paths", func(t *testing.T) {
+		for _, path := range []string{".id", "id.", "id..name", "items[", "items[]", "items[-1]", "items[+1]", "items[a]", `items["a"`, "items[0]sku", `prices["1.5]`} {
+			assert.True(t, errors.Is(json.Get[any](doc, path).UnwrapErr(), json.ErrInvalidPath))
+		}
+	})
+}
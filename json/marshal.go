@@ -0,0 +1,82 @@
+package json
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// Marshal marshals the given value into a JSON string. It delegates to the
+// standard library's json.Marshal function, so map keys come sorted and struct
+// fields in the order they are declared, making the output deterministic. If
+// the marshaling fails, it panics. See also TryMarshal and Canonical.
+func Marshal[In any](in In, options ...Option) string {
+	out, err := TryMarshal(in, options...)
+	if err != nil {
+		panic(err)
+	}
+
+	return out
+}
+
+// TryMarshal marshals the given value into a JSON string. It delegates to the
+// standard library's json.Marshal function, so map keys come sorted and struct
+// fields in the order they are declared, making the output deterministic. If
+// the marshaling fails, it returns the error. See also Marshal and Canonical.
+func TryMarshal[In any](in In, options ...Option) (string, error) {
+	out, err := marshal(in, newConfig(options))
+	if err != nil {
+		return "", err
+	}
+
+	return string(out), nil
+}
+
+// MarshalIndent marshals the given value into a JSON string like Marshal does,
+// but with each element on a new line starting with the given prefix followed
+// by one or more copies of the given indent, according to its nesting. If the
+// marshaling fails, it panics.
+func MarshalIndent[In any](in In, prefix string, indent string, options ...Option) string {
+	out, err := marshal(in, newConfig(options))
+	if err != nil {
+		panic(err)
+	}
+
+	var indented bytes.Buffer
+	_ = json.Indent(&indented, out, prefix, indent) // out is known to be valid
+
+	return indented.String()
+}
+
+func marshal[In any](in In, c config) ([]byte, error) {
+	out, err := json.Marshal(in)
+	if err != nil {
+		return nil, fmt.Errorf("failed marshaling json: %w", err)
+	}
+
+	if c.canonical {
+		return canonicalize(out)
+	}
+
+	return out, nil
+}
+
+// canonicalize rewrites the given JSON with the keys of all objects sorted and
+// no whitespace, leaving numbers as they were written.
+func canonicalize(in []byte) ([]byte, error) {
+	var document any
+
+	decoder := json.NewDecoder(bytes.NewReader(in))
+	decoder.UseNumber()
+
+	if err := decoder.Decode(&document); err != nil {
+		return nil, fmt.Errorf("failed marshaling json: %w", err)
+	}
+
+	out, err := json.Marshal(document)
+	if err != nil {
+		return nil, fmt.Errorf("failed marshaling json: %w", err)
+	}
+
+	return out, nil
+}
@@ -0,0 +1,91 @@
+package json_test
+
+import (
+	"testing"
+
+	"github.com/gtramontina/go-extlib/hashmap"
+	"github.com/gtramontina/go-extlib/json"
+	"github.com/gtramontina/go-extlib/testing/assert"
+)
+
+type unmarshalable struct {
+	Channel chan int
+}
+
+type handWritten struct{}
+
+func (handWritten) MarshalJSON() ([]byte, error) {
+	return []byte(`{ "z": 1.50, "a": [ true ] }`), nil
+}
+
+func TestJSONMarshal(t *testing.T) {
+	t.Run("primitives", func(t *testing.T) {
+		assert.Eq(t, json.Marshal(false), "false")
+		assert.Eq(t, json.Marshal(100), "100")
+		assert.Eq(t, json.Marshal(-1.5), "-1.5")
+		assert.Eq(t, json.Marshal("hello"), `"hello"`)
+		assert.Eq(t, json.Marshal[*int](nil), "null")
+		assert.Eq(t, json.Marshal(ptr(100)), "100")
+	})
+
+	t.Run("structs, slices and maps", func(t *testing.T) {
+		assert.Eq(t, json.Marshal(Person{Name: "John", Age: 30}), `{"Name":"John","Age":30}`)
+		assert.Eq(t, json.Marshal([]int{3, 1, 2}), `[3,1,2]`)
+		assert.Eq(t, json.Marshal(map[string]int{"c": 0, "a": 1, "b": 2}), `{"a":1,"b":2,"c":0}`)
+		assert.Eq(t, json.Marshal(map[int]bool{10: true, 2: false}), `{"10":true,"2":false}`)
+		assert.Eq(t, json.Marshal(hashmap.New(hashmap.Pair("b", 1), hashmap.Pair("a", 2))), `{"a":2,"b":1}`)
+	})
+
+	t.Run("marshals the same way every time", func(t *testing.T) {
+		in := map[string]int{}
+		for i := 0; i < 100; i++ {
+			in[string(rune('A'+i))] = i
+		}
+
+		expected := json.Marshal(in)
+		for i := 0; i < 10; i++ {
+			assert.Eq(t, json.Marshal(in), expected)
+		}
+	})
+
+	t.Run("mirrors unmarshal", func(t *testing.T) {
+		people := []Person{{Name: "John", Age: 30}, {Name: "Jane", Age: 25}}
+		assert.DeepEqual(t, json.Unmarshal[[]Person](json.Marshal(people)), people)
+	})
+
+	t.Run("sorts all keys and leaves out whitespace when canonical", func(t *testing.T) {
+		assert.Eq(t, json.Marshal(Person{Name: "John", Age: 30}, json.Canonical()), `{"Age":30,"Name":"John"}`)
+		assert.Eq(t, json.Marshal(handWritten{}), `{"z":1.50,"a":[true]}`)
+		assert.Eq(t, json.Marshal(handWritten{}, json.Canonical()), `{"a":[true],"z":1.50}`)
+		assert.Eq(t, json.Marshal([]any{Person{Name: "John", Age: 30}, "<&>"}, json.Canonical()), `[{"Age":30,"Name":"John"},"\u003c\u0026\u003e"]`)
+	})
+
+	t.Run("panics when marshaling fails", func(t *testing.T) {
+		assert.Panics(t, func() { json.Marshal(unmarshalable{Channel: nil}) })
+		assert.Panics(t, func() { json.MarshalIndent(unmarshalable{Channel: nil}, "", "  ") })
+	})
+}
+
+func TestJSONTryMarshal(t *testing.T) {
+	{
+		_, err := json.TryMarshal(unmarshalable{Channel: nil})
+		assert.Error(t, err)
+	}
+	{
+		out, err := json.TryMarshal(map[string][]int{"b": {1}, "a": {2, 3}})
+		assert.NoError(t, err)
+		assert.Eq(t, out, `{"a":[2,3],"b":[1]}`)
+	}
+	{
+		out, err := json.TryMarshal(Person{Name: "John", Age: 30}, json.Canonical())
+		assert.NoError(t, err)
+		assert.Eq(t, out, `{"Age":30,"Name":"John"}`)
+	}
+}
+
+func TestJSONMarshalIndent(t *testing.T) {
+	assert.Eq(t, json.MarshalIndent(42, "", "  "), "42")
+	assert.Eq(t, json.MarshalIndent(Person{Name: "John", Age: 30}, "", "  "), "{\n  \"Name\": \"John\",\n  \"Age\": 30\n}")
+	assert.Eq(t, json.MarshalIndent(Person{Name: "John", Age: 30}, "> ", "\t", json.Canonical()), "{\n> \t\"Age\": 30,\n> \t\"Name\": \"John\"\n> }")
+	assert.Eq(t, json.MarshalIndent([]int{}, "", "  "), "[]")
+}
@@ -0,0 +1,64 @@
+package json
+
+// Option customises how Parse unmarshals its input and how Marshal and its
+// variants marshal theirs. Options not concerning what is being done are
+// ignored.
+type Option func(config) config
+
+type config struct {
+	disallowUnknownFields bool
+	useNumber             bool
+	canonical             bool
+	validated             bool
+}
+
+// DisallowUnknownFields makes Parse fail when an object in the input holds a
+// key matching no field of the struct it is unmarshaled into.
+func DisallowUnknownFields() Option {
+	return func(c config) config {
+		c.disallowUnknownFields = true
+
+		return c
+	}
+}
+
+// UseNumber makes Parse unmarshal numbers into interface values as
+// json.Number instead of float64, keeping their original precision.
+func UseNumber() Option {
+	return func(c config) config {
+		c.useNumber = true
+
+		return c
+	}
+}
+
+// Canonical makes Marshal and its variants sort the keys of all objects,
+// including those marshaled from structs, and leave out any whitespace, so that
+// equal values always marshal to the same bytes. MarshalIndent still indents
+// the canonical output.
+func Canonical() Option {
+	return func(c config) config {
+		c.canonical = true
+
+		return c
+	}
+}
+
+// Validated makes Parse check the value parsed against the rules its fields are
+// tagged with, as told by Validate.
+func Validated() Option {
+	return func(c config) config {
+		c.validated = true
+
+		return c
+	}
+}
+
+func newConfig(options []Option) config {
+	c := config{disallowUnknownFields: false, useNumber: false, canonical: false, validated: false}
+	for _, option := range options {
+		c = option(c)
+	}
+
+	return c
+}
@@ -0,0 +1,68 @@
+package json
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+
+	"github.com/gtramontina/go-extlib/result"
+)
+
+// Parse unmarshals the given JSON string or byte slice into the given type,
+// returning a result.Result holding either the unmarshaled value or the error
+// the unmarshaling failed with. Unlike TryUnmarshal, its behaviour can be made
+// stricter through the given options; see DisallowUnknownFields, UseNumber and
+// Validated.
+//
+// Struct fields tagged as required, as in `json:"name,required"`, must be
+// present in the input, otherwise Parse fails with an error wrapping
+// ErrMissingField and telling the path of the missing field. A field set to
+// null is present.
+//
+// Given the Validated option, the value parsed is then checked against the
+// rules its fields are tagged with, as told by Validate. When any is broken,
+// Parse fails with an error wrapping the *ValidationError holding all
+// violations, or wrapping ErrInvalidRule when a rule is invalid.
+func Parse[Out any, In string | []byte](in In, options ...Option) result.Result[Out] {
+	c := newConfig(options)
+
+	decoder := json.NewDecoder(bytes.NewReader([]byte(in)))
+	if c.disallowUnknownFields {
+		decoder.DisallowUnknownFields()
+	}
+
+	if c.useNumber {
+		decoder.UseNumber()
+	}
+
+	var out Out
+
+	if err := decoder.Decode(&out); err != nil {
+		if errors.Is(err, io.EOF) {
+			err = io.ErrUnexpectedEOF
+		}
+
+		return result.Err[Out](fmt.Errorf("failed unmarshaling json: %w", err))
+	}
+
+	if _, err := decoder.Token(); !errors.Is(err, io.EOF) {
+		return result.Err[Out](fmt.Errorf("failed unmarshaling json: %w", ErrUnexpectedContent))
+	}
+
+	if err := checkRequired(reflect.TypeOf(&out).Elem(), []byte(in)); err != nil {
+		return result.Err[Out](fmt.Errorf("failed unmarshaling json: %w", err))
+	}
+
+	if !c.validated {
+		return result.Ok(out)
+	}
+
+	if err := Validate(out); err != nil {
+		return result.Err[Out](fmt.Errorf("failed unmarshaling json: %w", err))
+	}
+
+	return result.Ok(out)
+}
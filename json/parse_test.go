@@ -0,0 +1,108 @@
+package json_test
+
+import (
+	stdjson "encoding/json"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/gtramontina/go-extlib/json"
+	"github.com/gtramontina/go-extlib/maybe"
+	"github.com/gtramontina/go-extlib/result"
+	"github.com/gtramontina/go-extlib/testing/assert"
+)
+
+type Order struct {
+	ID       string      `json:"id,required"`
+	Customer *Customer   `json:"customer"`
+	Items    []OrderItem `json:"items,omitempty,required"`
+	Note     string      `json:"note"`
+}
+
+type Customer struct {
+	Name  string `json:"name,required"`
+	Email string `json:"email"`
+}
+
+type OrderItem struct {
+	Audit
+	SKU   string `json:"sku,required"`
+	Price maybe.Maybe[float64]
+}
+
+type Audit struct {
+	CreatedBy string `json:"createdBy,required"`
+}
+
+func TestJSONParse(t *testing.T) {
+	t.Run("parses into the given type", func(t *testing.T) {
+		assert.DeepEqual(t, json.Parse[int]("42"), result.Ok(42))
+		assert.DeepEqual(t, json.Parse[Person]([]byte(`{"Name":"John","Age":30}`)), result.Ok(Person{Name: "John", Age: 30}))
+		assert.DeepEqual(t, json.Parse[[]string](`["hello", "world"]`).Unwrap(), []string{"hello", "world"})
+	})
+
+	t.Run("fails on malformed json", func(t *testing.T) {
+		assert.True(t, json.Parse[int]("").IsErr())
+		assert.True(t, errors.Is(json.Parse[int]("").UnwrapErr(), io.ErrUnexpectedEOF))
+		assert.True(t, json.Parse[string](`"`).IsErr())
+		assert.True(t, json.Parse[int](`"not a number"`).IsErr())
+	})
+
+	t.Run("fails on content following the value", func(t *testing.T) {
+		assert.DeepEqual(t, json.Parse[int]("42 \n"), result.Ok(42))
+		assert.True(t, errors.Is(json.Parse[int]("42 43").UnwrapErr(), json.ErrUnexpectedContent))
+		assert.True(t, errors.Is(json.Parse[Person](`{"Name":"John"}}`).UnwrapErr(), json.ErrUnexpectedContent))
+	})
+
+	t.Run("ignores unknown fields unless told otherwise", func(t *testing.T) {
+		input := `{"Name":"John","Age":30,"Extra":"field"}`
+		assert.DeepEqual(t, json.Parse[Person](input), result.Ok(Person{Name: "John", Age: 30}))
+		assert.True(t, json.Parse[Person](input, json.DisallowUnknownFields()).IsErr())
+		assert.DeepEqual(t, json.Parse[Person](`{"Name":"John"}`, json.DisallowUnknownFields()), result.Ok(Person{Name: "John", Age: 0}))
+		assert.True(t, json.Parse[[]Person](`[{"Name":"John"},{"Nome":"Jane"}]`, json.DisallowUnknownFields()).IsErr())
+	})
+
+	t.Run("keeps numbers as json.Number when told to", func(t *testing.T) {
+		assert.DeepEqual(t, json.Parse[any]("12345678901234567890").Unwrap(), any(12345678901234567890.0))
+		assert.DeepEqual(t, json.Parse[any]("12345678901234567890", json.UseNumber()).Unwrap(), any(stdjson.Number("12345678901234567890")))
+		assert.DeepEqual(t, json.Parse[int]("42", json.UseNumber()), result.Ok(42))
+	})
+
+	t.Run("requires fields tagged as required", func(t *testing.T) {
+		missing := func(outcome result.Result[Order]) string {
+			t.Helper()
+
+			assert.True(t, errors.Is(outcome.UnwrapErr(), json.ErrMissingField))
+
+			return outcome.UnwrapErr().Error()
+		}
+
+		assert.DeepEqual(t, json.Parse[Order](`{"id":"1","items":[]}`), result.Ok(Order{ID: "1", Customer: nil, Items: []OrderItem{}, Note: ""}))
+		assert.True(t, json.Parse[Order](`{"ID":"1","items":null}`).IsOk())
+		assert.Eq(t, missing(json.Parse[Order](`{"items":[]}`)), "failed unmarshaling json: missing required field: id")
+		assert.Eq(t, missing(json.Parse[Order](`{"id":"1"}`)), "failed unmarshaling json: missing required field: items")
+		assert.Eq(t, missing(json.Parse[Order](`{"id":"1","items":[],"customer":{}}`)), "failed unmarshaling json: missing required field: customer.name")
+		assert.True(t, json.Parse[Order](`{"id":"1","items":[],"customer":null}`).IsOk())
+	})
+
+	t.Run("tells the path of missing fields within collections and embedded structs", func(t *testing.T) {
+		item := `{"createdBy":"john","sku":"a"}`
+		assert.True(t, json.Parse[Order](`{"id":"1","items":[`+item+`,`+item+`]}`).IsOk())
+		assert.Eq(t,
+			json.Parse[Order](`{"id":"1","items":[`+item+`,{"createdBy":"jane"}]}`).UnwrapErr().Error(),
+			"failed unmarshaling json: missing required field: items[1].sku",
+		)
+		assert.Eq(t,
+			json.Parse[Order](`{"id":"1","items":[`+item+`,{"sku":"b"}]}`).UnwrapErr().Error(),
+			"failed unmarshaling json: missing required field: items[1].createdBy",
+		)
+		assert.Eq(t,
+			json.Parse[map[string]Customer](`{"b":{},"a":{"name":"John"}}`).UnwrapErr().Error(),
+			"failed unmarshaling json: missing required field: b.name",
+		)
+		assert.Eq(t,
+			json.Parse[[]*Customer](`[{"name":"John"},null,{}]`).UnwrapErr().Error(),
+			"failed unmarshaling json: missing required field: [2].name",
+		)
+	})
+}
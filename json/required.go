@@ -0,0 +1,200 @@
+package json
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// ErrMissingField is wrapped by the errors of Parse when a field tagged as
+// required is missing from the input.
+var ErrMissingField = errors.New("missing required field")
+
+var unmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+
+// checkRequired checks that the given input, already known to unmarshal into
+// the given type, holds every field tagged as required. The input is only
+// looked at again when the type holds any such field.
+func checkRequired(typ reflect.Type, in []byte) error {
+	if !hasRequired(typ, map[reflect.Type]bool{}) {
+		return nil
+	}
+
+	var document any
+
+	decoder := json.NewDecoder(bytes.NewReader(in))
+	decoder.UseNumber()
+
+	if err := decoder.Decode(&document); err != nil {
+		return err //nolint:wrapcheck // the input already unmarshaled once, so this is not expected
+	}
+
+	return missingField(typ, document, "")
+}
+
+// hasRequired tells whether the given type holds, at any depth, a struct field
+// tagged as required. Types unmarshaling themselves are not looked into.
+func hasRequired(typ reflect.Type, seen map[reflect.Type]bool) bool {
+	if seen[typ] || reflect.PointerTo(typ).Implements(unmarshalerType) {
+		return false
+	}
+
+	seen[typ] = true
+
+	switch typ.Kind() { //nolint:exhaustive // other kinds hold no fields
+	case reflect.Pointer, reflect.Slice, reflect.Array, reflect.Map:
+		return hasRequired(typ.Elem(), seen)
+	case reflect.Struct:
+		for i := 0; i < typ.NumField(); i++ {
+			field := typ.Field(i)
+			if _, required, ok := fieldTag(field); ok && (required || hasRequired(field.Type, seen)) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// missingField walks the given decoded value along with the type it was
+// unmarshaled into, returning an error telling the path of the first required
+// field it finds missing.
+func missingField(typ reflect.Type, value any, path string) error {
+	if reflect.PointerTo(typ).Implements(unmarshalerType) {
+		return nil
+	}
+
+	switch typ.Kind() { //nolint:exhaustive // other kinds hold no fields
+	case reflect.Pointer:
+		return missingField(typ.Elem(), value, path)
+	case reflect.Slice, reflect.Array:
+		items, _ := value.([]any)
+		for i, item := range items {
+			if err := missingField(typ.Elem(), item, fmt.Sprintf("%s[%d]", path, i)); err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		object, _ := value.(map[string]any)
+
+		keys := make([]string, 0, len(object))
+		for key := range object {
+			keys = append(keys, key)
+		}
+
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			if err := missingField(typ.Elem(), object[key], join(path, key)); err != nil {
+				return err
+			}
+		}
+	case reflect.Struct:
+		object, isObject := value.(map[string]any)
+		if isObject {
+			return missingStructField(typ, object, path)
+		}
+	}
+
+	return nil
+}
+
+func missingStructField(typ reflect.Type, object map[string]any, path string) error {
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+
+		name, required, ok := fieldTag(field)
+		if !ok {
+			continue
+		}
+
+		if embedded(field, name) {
+			if err := missingField(field.Type, object, path); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+
+		value, present := lookup(object, name)
+		if !present {
+			if required {
+				return fmt.Errorf("%w: %s", ErrMissingField, join(path, name))
+			}
+
+			continue
+		}
+
+		if err := missingField(field.Type, value, join(path, name)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// fieldTag returns the name and whether the given field is required, as told
+// by its json tag. The boolean result reports whether the field is unmarshaled
+// at all.
+func fieldTag(field reflect.StructField) (string, bool, bool) {
+	tag := field.Tag.Get("json")
+	if tag == "-" || (!field.IsExported() && !field.Anonymous) {
+		return "", false, false
+	}
+
+	name, options, _ := strings.Cut(tag, ",")
+	for _, option := range strings.Split(options, ",") {
+		if option == "required" {
+			return name, true, true
+		}
+	}
+
+	return name, false, true
+}
+
+// embedded tells whether the given field is an embedded struct whose fields
+// are promoted to the object holding it.
+func embedded(field reflect.StructField, name string) bool {
+	if !field.Anonymous || name != "" {
+		return false
+	}
+
+	typ := field.Type
+	if typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+
+	return typ.Kind() == reflect.Struct
+}
+
+// lookup finds the value for the given key, preferring an exact match but,
+// like json.Unmarshal, accepting a case-insensitive one.
+func lookup(object map[string]any, key string) (any, bool) {
+	if value, present := object[key]; present {
+		return value, true
+	}
+
+	for candidate, value := range object {
+		if strings.EqualFold(candidate, key) {
+			return value, true
+		}
+	}
+
+	return nil, false
+}
+
+func join(path string, name string) string {
+	if path == "" {
+		return name
+	}
+
+	return path + "." + name
+}
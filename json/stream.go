@@ -0,0 +1,292 @@
+package json
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/gtramontina/go-extlib/iterator"
+	"github.com/gtramontina/go-extlib/result"
+)
+
+// ErrUnexpectedContent is wrapped by StreamError when a top-level array is
+// either cut short or followed by anything but whitespace, and by the errors of
+// Parse when the value parsed is followed by anything but whitespace.
+var ErrUnexpectedContent = errors.New("unexpected content")
+
+// StreamError is the error held by the Err results of Stream. It tells where in
+// the input the error was found.
+type StreamError struct {
+	// Line is the number of the line the error was found at, starting at 1.
+	Line int
+
+	// Offset is the number of bytes read from the input before the error was
+	// found.
+	Offset int64
+
+	// Err is the underlying error.
+	Err error
+}
+
+func (e *StreamError) Error() string {
+	return fmt.Sprintf("failed unmarshaling json at line %d, offset %d: %s", e.Line, e.Offset, e.Err.Error())
+}
+
+func (e *StreamError) Unwrap() error {
+	return e.Err
+}
+
+// Stream unmarshals the JSON values read from the given reader into the given
+// type, one at a time, as the returned iterator is walked through. Only the
+// value being unmarshaled is kept in memory, so arbitrarily large inputs can be
+// streamed.
+//
+// When the input starts with '[', it is read as a top-level array and its
+// items are unmarshaled. Otherwise, it is read as newline-delimited JSON
+// (NDJSON), with one value per line; blank lines are skipped.
+//
+// Each item yields a result.Result, holding either the unmarshaled value or a
+// *StreamError telling where the failure happened. Failing to unmarshal a value
+// into the given type does not end the stream, and neither does malformed JSON
+// in NDJSON, where the offending line is skipped. Malformed JSON in a
+// top-level array, however, ends the stream.
+func Stream[Out any](reader io.Reader) iterator.Iterator[result.Result[Out]] {
+	lines := &lineCounter{reader: reader, read: 0, newlines: nil, dropped: 0}
+
+	return &stream[Out]{source: bufio.NewReader(lines), lines: lines, read: 0, decoder: nil, next: nil, done: false}
+}
+
+// stream reads NDJSON line by line, keeping track of how many bytes it read.
+// Once it finds a top-level array, it hands reading over to a json.Decoder
+// instead, and read holds the number of bytes preceding the array.
+type stream[Out any] struct {
+	source  *bufio.Reader
+	lines   *lineCounter
+	read    int64
+	decoder *json.Decoder
+	next    *result.Result[Out]
+	done    bool
+}
+
+func (s *stream[Out]) HasNext() bool {
+	if s.next == nil && !s.done {
+		s.advance()
+	}
+
+	return s.next != nil
+}
+
+func (s *stream[Out]) Next() result.Result[Out] {
+	if !s.HasNext() {
+		panic(iterator.ErrIteratorEmpty)
+	}
+
+	next := *s.next
+	s.next = nil
+
+	return next
+}
+
+func (s *stream[Out]) Collect() []result.Result[Out] {
+	collected := make([]result.Result[Out], 0)
+	for s.HasNext() {
+		collected = append(collected, s.Next())
+	}
+
+	return collected
+}
+
+// advance reads up to the next item, deciding whether the input is a top-level
+// array or NDJSON when reading the first one.
+func (s *stream[Out]) advance() {
+	if s.decoder != nil {
+		s.advanceArray()
+
+		return
+	}
+
+	if s.read == 0 && s.startsWithArray() {
+		s.decoder = json.NewDecoder(s.source)
+		_, _ = s.decoder.Token() // the opening bracket, already peeked
+
+		s.advanceArray()
+
+		return
+	}
+
+	s.advanceLine()
+}
+
+// startsWithArray skips leading whitespace, telling whether what follows is the
+// opening bracket of an array.
+func (s *stream[Out]) startsWithArray() bool {
+	for {
+		peeked, err := s.source.Peek(1)
+		if err != nil {
+			return false
+		}
+
+		if !isSpace(peeked[0]) {
+			return peeked[0] == '['
+		}
+
+		_, _ = s.source.ReadByte()
+		s.read++
+	}
+}
+
+func (s *stream[Out]) advanceLine() {
+	for {
+		line, err := s.source.ReadBytes('\n')
+		start := s.read
+		s.read += int64(len(line))
+
+		if len(bytes.TrimSpace(line)) > 0 {
+			s.emit(s.unmarshal(line, start, s.lines.at(start)))
+
+			return
+		}
+
+		if err != nil {
+			s.finish(err)
+
+			return
+		}
+	}
+}
+
+func (s *stream[Out]) advanceArray() {
+	if !s.decoder.More() {
+		s.finishArray()
+
+		return
+	}
+
+	var raw json.RawMessage
+	if err := s.decoder.Decode(&raw); err != nil {
+		s.fail(err, s.base()+s.decoder.InputOffset())
+
+		return
+	}
+
+	start := s.base() + s.decoder.InputOffset() - int64(len(raw))
+	s.emit(s.unmarshal(raw, start, s.lines.at(start)))
+}
+
+// finishArray checks that the top-level array is closed and followed by nothing
+// but whitespace.
+func (s *stream[Out]) finishArray() {
+	closing, err := s.decoder.Token()
+	if err == nil && closing == json.Delim(']') {
+		_, err = s.decoder.Token()
+		if errors.Is(err, io.EOF) {
+			s.done = true
+
+			return
+		}
+	}
+
+	if err == nil || errors.Is(err, io.EOF) {
+		err = ErrUnexpectedContent
+	}
+
+	s.fail(err, s.base()+s.decoder.InputOffset())
+}
+
+// base is the number of bytes skipped before the top-level array.
+func (s *stream[Out]) base() int64 {
+	return s.read
+}
+
+// unmarshal unmarshals the given value, found at the given offset and line.
+func (s *stream[Out]) unmarshal(value []byte, offset int64, line int) result.Result[Out] {
+	var out Out
+
+	err := json.Unmarshal(value, &out)
+	if err == nil {
+		return result.Ok(out)
+	}
+
+	var syntaxError *json.SyntaxError
+
+	var typeError *json.UnmarshalTypeError
+
+	switch {
+	case errors.As(err, &syntaxError):
+		offset += syntaxError.Offset
+	case errors.As(err, &typeError):
+		offset += typeError.Offset
+	}
+
+	return result.Err[Out](&StreamError{Line: line, Offset: offset, Err: err})
+}
+
+func (s *stream[Out]) emit(next result.Result[Out]) {
+	s.next = &next
+}
+
+// fail emits an error found at the given offset, ending the stream.
+func (s *stream[Out]) fail(err error, offset int64) {
+	var syntaxError *json.SyntaxError
+	if errors.As(err, &syntaxError) {
+		offset = s.base() + syntaxError.Offset
+	}
+
+	s.emit(result.Err[Out](&StreamError{Line: s.lines.at(offset), Offset: offset, Err: err}))
+	s.done = true
+}
+
+// finish ends the stream, emitting the given error unless it tells the input
+// was fully read.
+func (s *stream[Out]) finish(err error) {
+	s.done = true
+
+	if !errors.Is(err, io.EOF) {
+		s.emit(result.Err[Out](&StreamError{Line: s.lines.at(s.read), Offset: s.read, Err: err}))
+	}
+}
+
+// lineCounter counts the lines of what is read through it, telling the line
+// any offset read so far is at. Offsets must be asked for in ascending order,
+// as it only remembers where the lines after the last offset asked for start,
+// keeping its memory bounded.
+type lineCounter struct {
+	reader   io.Reader
+	read     int64
+	newlines []int64
+	dropped  int
+}
+
+func (c *lineCounter) Read(buffer []byte) (int, error) {
+	n, err := c.reader.Read(buffer)
+
+	for i, b := range buffer[:n] {
+		if b == '\n' {
+			c.newlines = append(c.newlines, c.read+int64(i))
+		}
+	}
+
+	c.read += int64(n)
+
+	return n, err //nolint:wrapcheck // behaving as the wrapped reader
+}
+
+// at returns the line the given offset is at, starting at 1.
+func (c *lineCounter) at(offset int64) int {
+	passed := 0
+	for passed < len(c.newlines) && c.newlines[passed] < offset {
+		passed++
+	}
+
+	c.dropped += passed
+	c.newlines = c.newlines[passed:]
+
+	return c.dropped + 1
+}
+
+func isSpace(b byte) bool {
+	return b == ' ' || b == '\t' || b == '\r' || b == '\n'
+}
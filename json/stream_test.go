@@ -0,0 +1,138 @@
+package json_test
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/gtramontina/go-extlib/json"
+	"github.com/gtramontina/go-extlib/result"
+	"github.com/gtramontina/go-extlib/testing/assert"
+)
+
+func streamError(t *testing.T, outcome result.Result[Person]) *json.StreamError {
+	t.Helper()
+
+	var streamErr *json.StreamError
+
+	assert.True(t, outcome.IsErr())
+	assert.True(t, errors.As(outcome.UnwrapErr(), &streamErr))
+
+	return streamErr
+}
+
+// endlessArray is an endless top-level array of people.
+type endlessArray struct{ started bool }
+
+func (r *endlessArray) Read(buffer []byte) (int, error) {
+	if !r.started {
+		r.started = true
+
+		return copy(buffer, "["), nil
+	}
+
+	return copy(buffer, `{"Name":"John","Age":30},`), nil
+}
+
+func TestJSONStream(t *testing.T) {
+	john, jane := Person{Name: "John", Age: 30}, Person{Name: "Jane", Age: 25}
+
+	t.Run("empty input", func(t *testing.T) {
+		assert.False(t, json.Stream[Person](strings.NewReader("")).HasNext())
+		assert.False(t, json.Stream[Person](strings.NewReader(" \n\n ")).HasNext())
+		assert.False(t, json.Stream[Person](strings.NewReader(" [ ] ")).HasNext())
+	})
+
+	t.Run("newline-delimited values", func(t *testing.T) {
+		stream := json.Stream[Person](strings.NewReader("{\"Name\":\"John\", \"Age\":30}\n\n{\"Name\":\"Jane\", \"Age\":25}"))
+		assert.True(t, stream.HasNext())
+		assert.True(t, stream.HasNext())
+		assert.Equals(t, stream.Next(), result.Ok(john))
+		assert.Equals(t, stream.Next(), result.Ok(jane))
+		assert.False(t, stream.HasNext())
+		assert.Panics(t, func() { stream.Next() })
+	})
+
+	t.Run("items of a top-level array", func(t *testing.T) {
+		stream := json.Stream[Person](strings.NewReader("\n [\n{\"Name\":\"John\", \"Age\":30},\n {\"Name\":\"Jane\", \"Age\":25}\n]\n"))
+		assert.DeepEqual(t, stream.Collect(), []result.Result[Person]{result.Ok(john), result.Ok(jane)})
+		assert.DeepEqual(t, json.Stream[int](strings.NewReader("[1,2,3]")).Collect(), []result.Result[int]{
+			result.Ok(1), result.Ok(2), result.Ok(3),
+		})
+	})
+
+	t.Run("one element at a time", func(t *testing.T) {
+		stream := json.Stream[Person](&endlessArray{})
+		for i := 0; i < 10_000; i++ {
+			assert.Equals(t, stream.Next(), result.Ok(john))
+		}
+	})
+
+	t.Run("keeps going past values of the wrong type", func(t *testing.T) {
+		stream := json.Stream[Person](strings.NewReader("{\"Name\":\"John\", \"Age\":30}\n{\"Name\":\"Jane\", \"Age\":\"25\"}\n{\"Name\":\"Jane\", \"Age\":25}\n"))
+		assert.Equals(t, stream.Next(), result.Ok(john))
+
+		failed := streamError(t, stream.Next())
+		assert.Eq(t, failed.Line, 2)
+		assert.True(t, failed.Offset > 26 && failed.Offset <= 26+29)
+
+		assert.Equals(t, stream.Next(), result.Ok(jane))
+		assert.False(t, stream.HasNext())
+
+		array := json.Stream[Person](strings.NewReader("[\n{\"Name\":\"John\", \"Age\":30},\n{\"Name\":1},\n{\"Name\":\"Jane\", \"Age\":25}]"))
+		assert.Equals(t, array.Next(), result.Ok(john))
+
+		failed = streamError(t, array.Next())
+		assert.Eq(t, failed.Line, 3)
+		assert.True(t, failed.Offset > 30 && failed.Offset <= 30+10)
+
+		assert.Equals(t, array.Next(), result.Ok(jane))
+		assert.False(t, array.HasNext())
+	})
+
+	t.Run("skips malformed lines", func(t *testing.T) {
+		stream := json.Stream[Person](strings.NewReader("{\"Name\":\"John\", \"Age\":30}\n{\"Name\" \"Jane\"}\n{\"Name\":\"Jane\", \"Age\":25}"))
+		assert.Equals(t, stream.Next(), result.Ok(john))
+
+		failed := streamError(t, stream.Next())
+		assert.Eq(t, failed.Line, 2)
+		assert.Eq(t, failed.Offset, int64(26+9))
+		assert.Eq(t, failed.Error(), "failed unmarshaling json at line 2, offset 35: invalid character '\"' after object key")
+
+		assert.Equals(t, stream.Next(), result.Ok(jane))
+	})
+
+	t.Run("ends at malformed arrays", func(t *testing.T) {
+		stream := json.Stream[Person](strings.NewReader("[\n{\"Name\":\"John\", \"Age\":30},\n{\"Name\" \"Jane\"},\n{\"Name\":\"Jane\", \"Age\":25}]"))
+		assert.Equals(t, stream.Next(), result.Ok(john))
+
+		failed := streamError(t, stream.Next())
+		assert.Eq(t, failed.Line, 3)
+		assert.Eq(t, failed.Offset, int64(30+8))
+		assert.False(t, stream.HasNext())
+
+		for _, cutShort := range []string{"[1, 2", "[1, 2] 3"} {
+			ints := json.Stream[int](strings.NewReader(cutShort))
+			assert.Equals(t, ints.Next(), result.Ok(1))
+			assert.Equals(t, ints.Next(), result.Ok(2))
+			assert.True(t, ints.Next().IsErr())
+			assert.False(t, ints.HasNext())
+		}
+	})
+
+	t.Run("reports read failures", func(t *testing.T) {
+		stream := json.Stream[Person](io.MultiReader(strings.NewReader("{\"Name\":\"John\", \"Age\":30}\n"), failingReader{}))
+		assert.Equals(t, stream.Next(), result.Ok(john))
+
+		failed := streamError(t, stream.Next())
+		assert.True(t, errors.Is(failed, errBrokenReader))
+		assert.False(t, stream.HasNext())
+	})
+}
+
+var errBrokenReader = errors.New("broken reader")
+
+type failingReader struct{}
+
+func (failingReader) Read([]byte) (int, error) { return 0, errBrokenReader }
@@ -0,0 +1,275 @@
+package json
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// ErrInvalidRule is wrapped by the errors of Validate when a rule is unknown or
+// does not apply to the value it tags.
+var ErrInvalidRule = errors.New("invalid validation rule")
+
+// ruleTag is the struct tag holding the rules checked by Validate. It is named
+// after this library so that it never clashes with the tags of other
+// validation libraries.
+const ruleTag = "extlib"
+
+// Violation tells a value breaking one of the rules it is tagged with.
+type Violation struct {
+	// Path is the JSON path of the offending value, as in "items[3].price",
+	// or empty for the value validated itself.
+	Path string
+
+	// Rule is the rule broken, as written in the tag, like "min=1".
+	Rule string
+
+	// Message describes how the value breaks the rule.
+	Message string
+}
+
+func (v Violation) String() string {
+	if v.Path == "" {
+		return v.Message
+	}
+
+	return v.Path + ": " + v.Message
+}
+
+// ValidationError is the error returned by Validate, and wrapped by the errors
+// of Parse, holding every Violation found.
+type ValidationError struct {
+	Violations []Violation
+}
+
+func (e *ValidationError) Error() string {
+	messages := make([]string, 0, len(e.Violations))
+	for _, violation := range e.Violations {
+		messages = append(messages, violation.String())
+	}
+
+	return "invalid value: " + strings.Join(messages, "; ")
+}
+
+// Validate checks the given value against the rules its struct fields are
+// tagged with, walking through nested structs, pointers, slices, arrays and
+// maps. Rules are given in the extlib tag, separated by commas, as in
+// `extlib:"required,minlen=1,maxlen=10"`:
+//
+//   - required: the value must not be zero, nil or empty;
+//   - min=N and max=N: the number must be at least or at most N;
+//   - minlen=N and maxlen=N: the string, slice, array or map must have at least
+//     or at most N items, counting runes for strings;
+//   - oneof=A|B|C: the value, as formatted by fmt.Sprint, must be one of those
+//     given.
+//
+// Nil pointers only break the required rule. Rather than stopping at the first
+// Violation, Validate returns a *ValidationError holding all of them, each
+// with the JSON path of the offending value; it returns nil when there are
+// none. When a rule is unknown or does not apply to the value it tags, it
+// returns an error wrapping ErrInvalidRule instead.
+func Validate[Type any](value Type) (err error) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			invalid, ok := recovered.(invalidRule)
+			if !ok {
+				panic(recovered)
+			}
+
+			err = invalid.err
+		}
+	}()
+
+	var violations []Violation
+
+	validate(reflect.ValueOf(&value).Elem(), "", &violations)
+
+	if len(violations) == 0 {
+		return nil
+	}
+
+	return &ValidationError{Violations: violations}
+}
+
+// invalidRule is panicked with while walking a value when a rule is found to be
+// invalid, and recovered from by Validate to return its error.
+type invalidRule struct{ err error }
+
+func invalid(rule string, reason string) invalidRule {
+	return invalidRule{err: fmt.Errorf("%w %q: %s", ErrInvalidRule, rule, reason)}
+}
+
+// validate walks the given value, collecting the violations of the rules
+// tagged along the way.
+func validate(value reflect.Value, path string, violations *[]Violation) {
+	switch value.Kind() { //nolint:exhaustive // other kinds hold no fields
+	case reflect.Pointer, reflect.Interface:
+		if !value.IsNil() {
+			validate(value.Elem(), path, violations)
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < value.Len(); i++ {
+			validate(value.Index(i), fmt.Sprintf("%s[%d]", path, i), violations)
+		}
+	case reflect.Map:
+		keys := value.MapKeys()
+		sort.Slice(keys, func(a, z int) bool { return fmt.Sprint(keys[a]) < fmt.Sprint(keys[z]) })
+
+		for _, key := range keys {
+			validate(value.MapIndex(key), join(path, fmt.Sprint(key)), violations)
+		}
+	case reflect.Struct:
+		validateStruct(value, path, violations)
+	}
+}
+
+func validateStruct(value reflect.Value, path string, violations *[]Violation) {
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
+
+		name, _, ok := fieldTag(field)
+		if !ok || (!field.IsExported() && !embedded(field, name)) {
+			continue
+		}
+
+		if embedded(field, name) {
+			validate(value.Field(i), path, violations)
+
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+
+		fieldPath := join(path, name)
+
+		if rules, tagged := field.Tag.Lookup(ruleTag); tagged {
+			for _, rule := range strings.Split(rules, ",") {
+				if message, broken := check(value.Field(i), rule); broken {
+					*violations = append(*violations, Violation{Path: fieldPath, Rule: rule, Message: message})
+				}
+			}
+		}
+
+		validate(value.Field(i), fieldPath, violations)
+	}
+}
+
+// check tells whether the given value breaks the given rule and, if so, how.
+func check(value reflect.Value, rule string) (string, bool) {
+	name, argument, _ := strings.Cut(rule, "=")
+
+	if name == "required" {
+		return "is required", isEmpty(value)
+	}
+
+	for value.Kind() == reflect.Pointer {
+		if value.IsNil() {
+			return "", false
+		}
+
+		value = value.Elem()
+	}
+
+	switch name {
+	case "min":
+		return fmt.Sprintf("must be at least %s", argument), compare(value, argument, rule) < 0
+	case "max":
+		return fmt.Sprintf("must be at most %s", argument), compare(value, argument, rule) > 0
+	case "minlen":
+		return fmt.Sprintf("must have a length of at least %s", argument), length(value, rule) < bound(argument, rule)
+	case "maxlen":
+		return fmt.Sprintf("must have a length of at most %s", argument), length(value, rule) > bound(argument, rule)
+	case "oneof":
+		options := strings.Split(argument, "|")
+
+		return fmt.Sprintf("must be one of %s", strings.Join(options, ", ")), !contains(options, fmt.Sprint(value))
+	default:
+		panic(invalid(rule, "unknown rule"))
+	}
+}
+
+func isEmpty(value reflect.Value) bool {
+	switch value.Kind() { //nolint:exhaustive // other kinds are empty when zero
+	case reflect.String, reflect.Slice, reflect.Map:
+		return value.Len() == 0
+	default:
+		return value.IsZero()
+	}
+}
+
+// compare compares the given number with the given argument, returning -1, 0
+// or 1 as the number is less than, equal to or greater than the argument.
+func compare(value reflect.Value, argument string, rule string) int {
+	switch {
+	case value.CanInt():
+		other, err := strconv.ParseInt(argument, 10, 64)
+		if err != nil {
+			panic(invalid(rule, err.Error()))
+		}
+
+		return sign(value.Int() > other, value.Int() < other)
+	case value.CanUint():
+		other, err := strconv.ParseUint(argument, 10, 64)
+		if err != nil {
+			panic(invalid(rule, err.Error()))
+		}
+
+		return sign(value.Uint() > other, value.Uint() < other)
+	case value.CanFloat():
+		other, err := strconv.ParseFloat(argument, 64)
+		if err != nil {
+			panic(invalid(rule, err.Error()))
+		}
+
+		return sign(value.Float() > other, value.Float() < other)
+	default:
+		panic(invalid(rule, "does not apply to "+value.Type().String()))
+	}
+}
+
+func sign(greater bool, less bool) int {
+	switch {
+	case greater:
+		return 1
+	case less:
+		return -1
+	default:
+		return 0
+	}
+}
+
+func length(value reflect.Value, rule string) int {
+	switch value.Kind() { //nolint:exhaustive // other kinds have no length
+	case reflect.String:
+		return utf8.RuneCountInString(value.String())
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return value.Len()
+	default:
+		panic(invalid(rule, "does not apply to "+value.Type().String()))
+	}
+}
+
+func bound(argument string, rule string) int {
+	parsed, err := strconv.Atoi(argument)
+	if err != nil {
+		panic(invalid(rule, err.Error()))
+	}
+
+	return parsed
+}
+
+func contains(options []string, candidate string) bool {
+	for _, option := range options {
+		if option == candidate {
+			return true
+		}
+	}
+
+	return false
+}
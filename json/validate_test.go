@@ -0,0 +1,163 @@
+package json_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/gtramontina/go-extlib/json"
+	"github.com/gtramontina/go-extlib/testing/assert"
+)
+
+type Signup struct {
+	Name     string            `json:"name" extlib:"required,maxlen=5"`
+	Age      int               `json:"age" extlib:"min=18,max=130"`
+	Score    *float64          `json:"score,omitempty" extlib:"min=0.5"`
+	Plan     string            `json:"plan" extlib:"oneof=free|pro"`
+	Tags     []string          `json:"tags" extlib:"minlen=1,maxlen=2"`
+	Contacts []Contact         `json:"contacts"`
+	Labels   map[string]Label  `json:"labels"`
+	Ignored  string            `json:"-" extlib:"required"`
+	Extra    map[string]string `extlib:"maxlen=1"`
+}
+
+type Contact struct {
+	Kind  uint   `json:"kind" extlib:"max=2"`
+	Value string `json:"value" extlib:"required"`
+}
+
+type Label struct {
+	Text string `extlib:"minlen=2"`
+}
+
+func validSignup() Signup {
+	return Signup{
+		Name:     "John",
+		Age:      30,
+		Score:    nil,
+		Plan:     "pro",
+		Tags:     []string{"a"},
+		Contacts: []Contact{{Kind: 1, Value: "john@example.com"}},
+		Labels:   map[string]Label{"x": {Text: "xx"}},
+		Ignored:  "",
+		Extra:    nil,
+	}
+}
+
+func violations(t *testing.T, err error) []json.Violation {
+	t.Helper()
+
+	var validationErr *json.ValidationError
+
+	assert.True(t, errors.As(err, &validationErr))
+
+	return validationErr.Violations
+}
+
+func TestJSONValidate(t *testing.T) {
+	t.Run("finds nothing wrong with valid values", func(t *testing.T) {
+		assert.NoError(t, json.Validate(validSignup()))
+		assert.NoError(t, json.Validate(ptr(validSignup())))
+		assert.NoError(t, json.Validate([]Signup{validSignup()}))
+		assert.NoError(t, json.Validate(42))
+	})
+
+	t.Run("accumulates all violations with their paths", func(t *testing.T) {
+		signup := validSignup()
+		signup.Name = "Johnny"
+		signup.Age = 17
+		signup.Score = ptr(0.1)
+		signup.Plan = "gold"
+		signup.Tags = []string{}
+		signup.Contacts = append(signup.Contacts, Contact{Kind: 3, Value: ""})
+		signup.Labels["y"] = Label{Text: "é"}
+		signup.Extra = map[string]string{"a": "", "b": ""}
+
+		assert.DeepEqual(t, violations(t, json.Validate(signup)), []json.Violation{
+			{Path: "name", Rule: "maxlen=5", Message: "must have a length of at most 5"},
+			{Path: "age", Rule: "min=18", Message: "must be at least 18"},
+			{Path: "score", Rule: "min=0.5", Message: "must be at least 0.5"},
+			{Path: "plan", Rule: "oneof=free|pro", Message: "must be one of free, pro"},
+			{Path: "tags", Rule: "minlen=1", Message: "must have a length of at least 1"},
+			{Path: "contacts[1].kind", Rule: "max=2", Message: "must be at most 2"},
+			{Path: "contacts[1].value", Rule: "required", Message: "is required"},
+			{Path: "labels.y.Text", Rule: "minlen=2", Message: "must have a length of at least 2"},
+			{Path: "Extra", Rule: "maxlen=1", Message: "must have a length of at most 1"},
+		})
+	})
+
+	t.Run("tells all violations in its message", func(t *testing.T) {
+		signup := validSignup()
+		signup.Name = ""
+		signup.Age = 200
+
+		assert.Eq(t, json.Validate(signup).Error(), "invalid value: name: is required; age: must be at most 130")
+	})
+
+	t.Run("validates the fields of embedded structs as their own", func(t *testing.T) {
+		type Audited struct {
+			OrderItem
+			Reason string `json:"reason" extlib:"required"`
+		}
+
+		assert.DeepEqual(t, violations(t, json.Validate([]Audited{{}})), []json.Violation{
+			{Path: "[0].reason", Rule: "required", Message: "is required"},
+		})
+	})
+
+	t.Run("tells rules it cannot apply", func(t *testing.T) {
+		err := json.Validate(struct {
+			Name string `extlib:"unknown"`
+		}{Name: ""})
+		assert.True(t, errors.Is(err, json.ErrInvalidRule))
+		assert.Eq(t, err.Error(), `invalid validation rule "unknown": unknown rule`)
+
+		err = json.Validate(struct {
+			Name string `extlib:"min=1"`
+		}{Name: ""})
+		assert.True(t, errors.Is(err, json.ErrInvalidRule))
+		assert.Eq(t, err.Error(), `invalid validation rule "min=1": does not apply to string`)
+
+		err = json.Validate(struct {
+			Age int `extlib:"max=old"`
+		}{Age: 0})
+		assert.True(t, errors.Is(err, json.ErrInvalidRule))
+	})
+
+	t.Run("ignores the tags of other validation libraries", func(t *testing.T) {
+		assert.NoError(t, json.Validate(struct {
+			Email string `validate:"required,email"`
+		}{Email: ""}))
+	})
+}
+
+func TestJSONParseValidates(t *testing.T) {
+	t.Run("validates the value parsed when told to", func(t *testing.T) {
+		input := `{"name":"John","age":30,"plan":"pro","tags":["a"],"contacts":[{"kind":1,"value":"j"}]}`
+		assert.True(t, json.Parse[Signup](input, json.Validated()).IsOk())
+
+		invalid := `{"name":"John","age":3,"plan":"pro","tags":[],"contacts":[{"kind":1,"value":"j"}]}`
+		err := json.Parse[Signup](invalid, json.Validated()).UnwrapErr()
+
+		assert.Eq(t, err.Error(), "failed unmarshaling json: invalid value: age: must be at least 18; tags: must have a length of at least 1")
+		assert.Eq(t, len(violations(t, err)), 2)
+		assert.True(t, json.Parse[Signup](invalid).IsOk())
+	})
+
+	t.Run("fails rather than panics on invalid rules", func(t *testing.T) {
+		type Broken struct {
+			Name string `json:"name" extlib:"email"`
+		}
+
+		assert.True(t, json.Parse[Broken](`{"name":"john"}`).IsOk())
+		assert.True(t, errors.Is(json.Parse[Broken](`{"name":"john"}`, json.Validated()).UnwrapErr(), json.ErrInvalidRule))
+	})
+
+	t.Run("leaves the tags of other validation libraries alone", func(t *testing.T) {
+		type User struct {
+			Email string `json:"email" validate:"required,email"`
+		}
+
+		assert.Eq(t, json.Parse[User](`{"email":"john"}`).Unwrap(), User{Email: "john"})
+		assert.Eq(t, json.Parse[User](`{}`, json.Validated()).Unwrap(), User{Email: ""})
+	})
+}
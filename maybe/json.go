@@ -0,0 +1,36 @@
+package maybe
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// MarshalJSON encodes None as null and Some as its wrapped value. A Some
+// wrapping a value encoded as null, like a nil pointer, is thus decoded back
+// as None.
+func (m Maybe[Type]) MarshalJSON() ([]byte, error) {
+	if !m.present {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(m.value)
+}
+
+// UnmarshalJSON decodes null as None and any other value as Some. Missing
+// fields are left untouched and thus remain None.
+func (m *Maybe[Type]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		*m = None[Type]()
+
+		return nil
+	}
+
+	var value Type
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err //nolint:wrapcheck // surfacing the error as if decoding the value itself
+	}
+
+	*m = Some(value)
+
+	return nil
+}
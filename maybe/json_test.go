@@ -0,0 +1,51 @@
+package maybe_test
+
+import (
+	stdjson "encoding/json"
+	"testing"
+
+	"github.com/gtramontina/go-extlib/json"
+	"github.com/gtramontina/go-extlib/maybe"
+	"github.com/gtramontina/go-extlib/testing/assert"
+)
+
+func TestMaybeJSON(t *testing.T) {
+	type payload struct {
+		Name maybe.Maybe[string] `json:"name"`
+		Age  maybe.Maybe[int]    `json:"age"`
+	}
+
+	encode := func(value any) string {
+		encoded, err := stdjson.Marshal(value)
+		assert.NoError(t, err)
+
+		return string(encoded)
+	}
+
+	t.Run("encodes Some as its value and None as null", func(t *testing.T) {
+		assert.Eq(t, encode(maybe.Some(1)), `1`)
+		assert.Eq(t, encode(maybe.Some("value")), `"value"`)
+		assert.Eq(t, encode(maybe.Some([]int{1, 2})), `[1,2]`)
+		assert.Eq(t, encode(maybe.None[int]()), `null`)
+		assert.Eq(t, encode(payload{maybe.Some("Jane"), maybe.None[int]()}), `{"name":"Jane","age":null}`)
+	})
+
+	t.Run("decodes values as Some and null as None", func(t *testing.T) {
+		assert.Equals(t, json.Unmarshal[maybe.Maybe[int]](`1`), maybe.Some(1))
+		assert.Equals(t, json.Unmarshal[maybe.Maybe[string]](`"value"`), maybe.Some("value"))
+		assert.Equals(t, json.Unmarshal[maybe.Maybe[int]](`null`), maybe.None[int]())
+		assert.DeepEqual(t, json.Unmarshal[payload](`{"name":"Jane","age":null}`), payload{maybe.Some("Jane"), maybe.None[int]()})
+		assert.DeepEqual(t, json.Unmarshal[payload](`{"age":30}`), payload{maybe.None[string](), maybe.Some(30)})
+		assert.DeepEqual(t, json.Unmarshal[[]maybe.Maybe[int]](`[1,null]`), []maybe.Maybe[int]{maybe.Some(1), maybe.None[int]()})
+	})
+
+	t.Run("round-trips", func(t *testing.T) {
+		original := payload{maybe.Some("Jane"), maybe.Some(30)}
+		assert.DeepEqual(t, json.Unmarshal[payload](encode(original)), original)
+	})
+
+	t.Run("fails decoding values of the wrong type", func(t *testing.T) {
+		_, err := json.TryUnmarshal[maybe.Maybe[int]](`"value"`)
+		assert.Error(t, err)
+	})
+}
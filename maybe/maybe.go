@@ -1,49 +1,26 @@
 package maybe
 
+import (
+	"fmt"
+	"reflect"
+)
+
 // Maybe is a polymorphic type that represents the presence (Some) or absence
-// (None) of a value.
-type Maybe[Type any] interface {
-	// seal is used internally as a way of limiting external implementations
-	seal() string
-
-	// Equals allows comparing two Maybe[Type]. Returns true when they are equal
-	// to each other; false otherwise.
-	Equals(Maybe[Type]) bool
-
-	// String renders itself as a string.
-	String() string
-
-	// IsSome returns true if the Maybe[Type] is Some; false otherwise.
-	IsSome() bool
-
-	// IsNone returns true if the Maybe[Type] is None; false otherwise.
-	IsNone() bool
-
-	// Unwrap returns the wrapped value, if Some, panics if None. See also:
-	// UnwrapOr, UnwrapOrElse.
-	Unwrap() Type
-
-	// UnwrapOr returns the wrapped value, if Some, or the given default value.
-	// The usage of this function forces an early evaluation of the default
-	// value, in contrast to UnwrapOrElse. See also: Unwrap,  UnwrapOrElse.
-	UnwrapOr(Type) Type
-
-	// UnwrapOrElse returns the wrapped value, if Some, or evaluates the given
-	// function which provides a default.
-	// The usage of this function lazily evaluates the default value, in
-	// contrast to UnwrapOr. See also: Unwrap, UnwrapOr.
-	UnwrapOrElse(func() Type) Type
+// (None) of a value. Its zero value is None.
+type Maybe[Type any] struct {
+	value   Type
+	present bool
 }
 
 // Some wraps the given value with Maybe[Type]. It represents the presence of
 // the given value. See also: None, Of.
 func Some[Type any](value Type) Maybe[Type] {
-	return some[Type]{value}
+	return Maybe[Type]{value: value, present: true}
 }
 
 // None represents to absence of a value of the given type. See also None, Of.
 func None[Type any]() Maybe[Type] {
-	return none[Type]{}
+	return Maybe[Type]{}
 }
 
 // Of wraps the given value with Maybe[Type]. If the given value is nil,
@@ -52,10 +29,10 @@ func None[Type any]() Maybe[Type] {
 // Some, None.
 func Of[Type any](value any) Maybe[Type] {
 	if value != nil {
-		return some[Type]{value.(Type)}
+		return Some(value.(Type))
 	}
 
-	return none[Type]{}
+	return None[Type]()
 }
 
 // Match pattern-matches on the given Maybe[Type] and returns the result of the
@@ -63,8 +40,8 @@ func Of[Type any](value any) Maybe[Type] {
 // given the underlying value. If it is None[Type], then `whenNone` is
 // evaluated.
 func Match[From any, To any](maybe Maybe[From], whenSome func(From) To, whenNone func() To) To {
-	if it, ok := maybe.(some[From]); ok {
-		return whenSome(it.value)
+	if maybe.present {
+		return whenSome(maybe.value)
 	}
 
 	return whenNone()
@@ -74,8 +51,8 @@ func Match[From any, To any](maybe Maybe[From], whenSome func(From) To, whenNone
 // `mapper` function. The result of `mapper` is then wrapped with Maybe[Type],
 // or None[Type] if value is absent. See also: FlatMap.
 func Map[From any, To any](maybe Maybe[From], mapper func(From) To) Maybe[To] {
-	if it, ok := maybe.(some[From]); ok {
-		return Of[To](mapper(it.value))
+	if maybe.present {
+		return Of[To](mapper(maybe.value))
 	}
 
 	return None[To]()
@@ -85,8 +62,8 @@ func Map[From any, To any](maybe Maybe[From], mapper func(From) To) Maybe[To] {
 // `mapper` function. The result of `mapper` is then wrapped with Maybe[Type] if
 // not yet a Maybe[Type], or None[Type] if value is absent. See also: Map.
 func FlatMap[From any, To any](maybe Maybe[From], mapper func(From) any) Maybe[To] {
-	if it, ok := maybe.(some[From]); ok {
-		result := mapper(it.value)
+	if maybe.present {
+		result := mapper(maybe.value)
 		if maybeResult, ok := result.(Maybe[To]); ok {
 			return maybeResult
 		}
@@ -96,3 +73,67 @@ func FlatMap[From any, To any](maybe Maybe[From], mapper func(From) any) Maybe[T
 
 	return None[To]()
 }
+
+// Equals allows comparing two Maybe[Type]. Returns true when they are equal to
+// each other; false otherwise.
+func (m Maybe[Type]) Equals(other Maybe[Type]) bool {
+	if m.present != other.present {
+		return false
+	}
+
+	return !m.present || reflect.DeepEqual(m.value, other.value)
+}
+
+// String renders itself as a string.
+func (m Maybe[Type]) String() string {
+	if !m.present {
+		return "None()"
+	}
+
+	kind := reflect.TypeOf(m.value).String()
+
+	return "Some[" + kind + "](" + fmt.Sprintf("%+v", m.value) + ")"
+}
+
+// IsSome returns true if the Maybe[Type] is Some; false otherwise.
+func (m Maybe[Type]) IsSome() bool {
+	return m.present
+}
+
+// IsNone returns true if the Maybe[Type] is None; false otherwise.
+func (m Maybe[Type]) IsNone() bool {
+	return !m.present
+}
+
+// Unwrap returns the wrapped value, if Some, panics if None. See also:
+// UnwrapOr, UnwrapOrElse.
+func (m Maybe[Type]) Unwrap() Type {
+	if !m.present {
+		panic("nothing to unwrap from None()")
+	}
+
+	return m.value
+}
+
+// UnwrapOr returns the wrapped value, if Some, or the given default value. The
+// usage of this function forces an early evaluation of the default value, in
+// contrast to UnwrapOrElse. See also: Unwrap,  UnwrapOrElse.
+func (m Maybe[Type]) UnwrapOr(or Type) Type {
+	if !m.present {
+		return or
+	}
+
+	return m.value
+}
+
+// UnwrapOrElse returns the wrapped value, if Some, or evaluates the given
+// function which provides a default. The usage of this function lazily
+// evaluates the default value, in contrast to UnwrapOr. See also: Unwrap,
+// UnwrapOr.
+func (m Maybe[Type]) UnwrapOrElse(orElse func() Type) Type {
+	if !m.present {
+		return orElse()
+	}
+
+	return m.value
+}
@@ -0,0 +1,59 @@
+package result
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidJSON is returned when decoding JSON not shaped as a Result.
+var ErrInvalidJSON = errors.New(`result: expected either {"ok": value} or {"err": "message"}`)
+
+// encoded is the tagged object a Result is encoded as. Only the field matching
+// the state of the Result is present.
+type encoded struct {
+	Ok  json.RawMessage `json:"ok,omitempty"`
+	Err *string         `json:"err,omitempty"`
+}
+
+// MarshalJSON encodes Ok as {"ok": value} and Err as {"err": "message"}, where
+// the message is the one the error carries.
+func (r Result[Type]) MarshalJSON() ([]byte, error) {
+	if r.failed {
+		message := fmt.Sprint(r.err)
+
+		return json.Marshal(encoded{Ok: nil, Err: &message})
+	}
+
+	value, err := json.Marshal(r.value)
+	if err != nil {
+		return nil, err //nolint:wrapcheck // surfacing the error as if encoding the value itself
+	}
+
+	return json.Marshal(encoded{Ok: value, Err: nil})
+}
+
+// UnmarshalJSON decodes {"ok": value} as Ok and {"err": "message"} as Err,
+// holding an error carrying the given message.
+func (r *Result[Type]) UnmarshalJSON(data []byte) error {
+	var tagged encoded
+	if err := json.Unmarshal(data, &tagged); err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidJSON, err.Error())
+	}
+
+	switch {
+	case tagged.Ok != nil && tagged.Err == nil:
+		var value Type
+		if err := json.Unmarshal(tagged.Ok, &value); err != nil {
+			return err //nolint:wrapcheck // surfacing the error as if decoding the value itself
+		}
+
+		*r = Ok(value)
+	case tagged.Ok == nil && tagged.Err != nil:
+		*r = Err[Type](errors.New(*tagged.Err)) //nolint:err113 // recreating the encoded error
+	default:
+		return ErrInvalidJSON
+	}
+
+	return nil
+}
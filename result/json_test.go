@@ -0,0 +1,50 @@
+package result_test
+
+import (
+	stdjson "encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/gtramontina/go-extlib/json"
+	"github.com/gtramontina/go-extlib/result"
+	"github.com/gtramontina/go-extlib/testing/assert"
+)
+
+func TestResultJSON(t *testing.T) {
+	encode := func(value any) string {
+		encoded, err := stdjson.Marshal(value)
+		assert.NoError(t, err)
+
+		return string(encoded)
+	}
+
+	t.Run("encodes Ok and Err as tagged objects", func(t *testing.T) {
+		assert.Eq(t, encode(result.Ok(1)), `{"ok":1}`)
+		assert.Eq(t, encode(result.Ok([]string{"a"})), `{"ok":["a"]}`)
+		assert.Eq(t, encode(result.Ok[*int](nil)), `{"ok":null}`)
+		assert.Eq(t, encode(result.Err[int](errors.New("error message"))), `{"err":"error message"}`)
+	})
+
+	t.Run("decodes tagged objects", func(t *testing.T) {
+		assert.Equals(t, json.Unmarshal[result.Result[int]](`{"ok":1}`), result.Ok(1))
+		assert.Equals(t, json.Unmarshal[result.Result[*int]](`{"ok":null}`), result.Ok[*int](nil))
+		assert.Equals(t, json.Unmarshal[result.Result[int]](`{"err":"error message"}`), result.Err[int](errors.New("error message")))
+	})
+
+	t.Run("round-trips", func(t *testing.T) {
+		type payload struct{ Outcomes []result.Result[string] }
+
+		original := payload{[]result.Result[string]{result.Ok("value"), result.Err[string](errors.New("error message"))}}
+		assert.DeepEqual(t, json.Unmarshal[payload](encode(original)), original)
+	})
+
+	t.Run("fails decoding objects other than tagged ones", func(t *testing.T) {
+		for _, invalid := range []string{`{}`, `{"ok":1,"err":"error message"}`, `{"err":1}`, `[]`, `1`} {
+			_, err := json.TryUnmarshal[result.Result[int]](invalid)
+			assert.True(t, errors.Is(err, result.ErrInvalidJSON), invalid)
+		}
+
+		_, err := json.TryUnmarshal[result.Result[int]](`{"ok":"value"}`)
+		assert.Error(t, err)
+	})
+}
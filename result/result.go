@@ -1,67 +1,31 @@
 package result
 
-import "github.com/gtramontina/go-extlib/maybe"
-
-// Result represents a computation that may or may not have succeeded.
-type Result[Type any] interface {
-	// seal is used internally as a way of limiting external implementations. It
-	// marks the container as being in one of the two possible states.
-	seal() string
-
-	// Equals returns true if the result is equal to the given result.
-	Equals(Result[Type]) bool
-
-	// String returns a string representation of the result.
-	String() string
-
-	// IsOk returns true if the result is Ok. See also: IsErr.
-	IsOk() bool
-
-	// IsErr returns true if the result is Err. See also: IsOk.
-	IsErr() bool
-
-	// Ok returns a maybe.Maybe[Type] representation of the result. If it is Ok,
-	// a maybe.Some[Type] is returned, otherwise a maybe.None[Type] is returned.
-	Ok() maybe.Maybe[Type]
-
-	// Unwrap returns the value contained in the result. It panics if the result
-	// is Err. See also: UnwrapErr, UnwrapOr, UnwrapOrElse.
-	Unwrap() Type
-
-	// UnwrapErr returns the error contained in the result. It panics if the
-	// result is Ok. See also: Unwrap, UnwrapOr, UnwrapOrElse.
-	UnwrapErr() error
-
-	// UnwrapOr returns the value contained in the result or the default value
-	// if the result is Err. See also: Unwrap, UnwrapErr, UnwrapOrElse.
-	UnwrapOr(Type) Type
-
-	// UnwrapOrElse returns the value contained in the result or the result of
-	// calling the function if the result is Err. See also: Unwrap, UnwrapErr,
-	// UnwrapOr.
-	UnwrapOrElse(func() Type) Type
-
-	// Or performs a logical 'or' operation on the two given results. If the
-	// first result is Err, the second result is returned. Otherwise, the second
-	// result is returned. This is equivalent to result.Or. See also: And.
-	Or(Result[Type]) Result[Type]
-
-	// And performs a logical 'and' operation on the two given results. If the
-	// first result is Ok, the second result is returned. Otherwise, the first
-	// result is returned. This is almost equivalent to result.And. The
-	// difference is that this method does not remap the error type. See also:
-	// Or.
-	And(Result[Type]) Result[Type]
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/gtramontina/go-extlib/maybe"
+)
+
+// Result represents a computation that may or may not have succeeded: it is
+// either Ok, holding a value, or Err, holding an error. Its zero value is Ok,
+// holding the zero value of Type.
+type Result[Type any] struct {
+	value  Type
+	err    error
+	failed bool
 }
 
 // Ok returns a new Ok result. See also: Err, Of.
 func Ok[Type any](value Type) Result[Type] {
-	return ok[Type]{value}
+	return Result[Type]{value: value, err: nil, failed: false}
 }
 
 // Err returns a new Err result. See also: Ok, Of.
 func Err[Type any](value error) Result[Type] {
-	return err[Type]{value}
+	var zero Type
+
+	return Result[Type]{value: zero, err: value, failed: true}
 }
 
 // Of returns a new Ok result if the value is not nil, otherwise an Err result.
@@ -80,20 +44,20 @@ func Of[Type any](value Type, err error) Result[Type] {
 // and given the underlying error.
 func Match[Type any, Out any](result Result[Type], whenOk func(Type) Out, whenErr func(error) Out) Out {
 	if result.IsOk() {
-		return whenOk(result.(ok[Type]).value)
+		return whenOk(result.value)
 	}
 
-	return whenErr(result.(err[Type]).value)
+	return whenErr(result.err)
 }
 
 // Map maps a Result[Type] to Result[Out] by applying the given `mapper`
 // function to a contained Ok value, leaving an Err value untouched.
 func Map[Type any, Out any](result Result[Type], mapper func(Type) Out) Result[Out] {
 	if result.IsOk() {
-		return Ok[Out](mapper(result.(ok[Type]).value))
+		return Ok[Out](mapper(result.value))
 	}
 
-	return Err[Out](result.(err[Type]).value)
+	return Err[Out](result.err)
 }
 
 // FlatMap maps a Result[Type] to Result[Out] by applying the given `mapper`
@@ -101,7 +65,7 @@ func Map[Type any, Out any](result Result[Type], mapper func(Type) Out) Result[O
 // the result into a Result[Out] if not yet a Result[Type]. See also: Map.
 func FlatMap[From any, To any](result Result[From], mapper func(From) any) Result[To] {
 	if result.IsOk() {
-		mapped := mapper(result.(ok[From]).value)
+		mapped := mapper(result.value)
 		if mappedToResult, ok := mapped.(Result[To]); ok {
 			return mappedToResult
 		}
@@ -109,17 +73,17 @@ func FlatMap[From any, To any](result Result[From], mapper func(From) any) Resul
 		return Ok[To](mapped.(To))
 	}
 
-	return Err[To](result.(err[From]).value)
+	return Err[To](result.err)
 }
 
 // MapErr maps a Result[Type] to Result[Type] by applying the given `mapper`
 // function to a contained Err value, leaving an Ok value untouched.
 func MapErr[Type any](result Result[Type], mapper func(error) error) Result[Type] {
 	if result.IsOk() {
-		return Ok[Type](result.(ok[Type]).value)
+		return Ok[Type](result.value)
 	}
 
-	return Err[Type](mapper(result.(err[Type]).value))
+	return Err[Type](mapper(result.err))
 }
 
 // And performs a logical 'and' operation on the two given results. If the first
@@ -131,7 +95,7 @@ func And[Type any, Out any](resultA Result[Type], resultB Result[Out]) Result[Ou
 		return resultB
 	}
 
-	return Err[Out](resultA.(err[Type]).value)
+	return Err[Out](resultA.err)
 }
 
 // Or performs a logical 'or' operation on the two given results. If the first
@@ -140,3 +104,103 @@ func And[Type any, Out any](resultA Result[Type], resultB Result[Out]) Result[Ou
 func Or[Type any](resultA Result[Type], resultB Result[Type]) Result[Type] {
 	return resultA.Or(resultB)
 }
+
+// Equals returns true if the result is equal to the given result.
+func (r Result[Type]) Equals(other Result[Type]) bool {
+	return reflect.DeepEqual(r, other)
+}
+
+// String returns a string representation of the result.
+func (r Result[Type]) String() string {
+	if r.failed {
+		return "Err(" + fmt.Sprintf("%+v", r.err) + ")"
+	}
+
+	kind := reflect.TypeOf(r.value).String()
+
+	return "Ok[" + kind + "](" + fmt.Sprintf("%+v", r.value) + ")"
+}
+
+// IsOk returns true if the result is Ok. See also: IsErr.
+func (r Result[Type]) IsOk() bool {
+	return !r.failed
+}
+
+// IsErr returns true if the result is Err. See also: IsOk.
+func (r Result[Type]) IsErr() bool {
+	return r.failed
+}
+
+// Ok returns a maybe.Maybe[Type] representation of the result. If it is Ok, a
+// maybe.Some[Type] is returned, otherwise a maybe.None[Type] is returned.
+func (r Result[Type]) Ok() maybe.Maybe[Type] {
+	if r.failed {
+		return maybe.None[Type]()
+	}
+
+	return maybe.Some(r.value)
+}
+
+// Unwrap returns the value contained in the result. It panics if the result is
+// Err. See also: UnwrapErr, UnwrapOr, UnwrapOrElse.
+func (r Result[Type]) Unwrap() Type {
+	if r.failed {
+		panic(r.err)
+	}
+
+	return r.value
+}
+
+// UnwrapErr returns the error contained in the result. It panics if the result
+// is Ok. See also: Unwrap, UnwrapOr, UnwrapOrElse.
+func (r Result[Type]) UnwrapErr() error {
+	if !r.failed {
+		panic(r.value)
+	}
+
+	return r.err
+}
+
+// UnwrapOr returns the value contained in the result or the default value if
+// the result is Err. See also: Unwrap, UnwrapErr, UnwrapOrElse.
+func (r Result[Type]) UnwrapOr(or Type) Type {
+	if r.failed {
+		return or
+	}
+
+	return r.value
+}
+
+// UnwrapOrElse returns the value contained in the result or the result of
+// calling the function if the result is Err. See also: Unwrap, UnwrapErr,
+// UnwrapOr.
+func (r Result[Type]) UnwrapOrElse(orElse func() Type) Type {
+	if r.failed {
+		return orElse()
+	}
+
+	return r.value
+}
+
+// Or performs a logical 'or' operation on the two given results. If the first
+// result is Err, the second result is returned. Otherwise, the second result
+// is returned. This is equivalent to result.Or. See also: And.
+func (r Result[Type]) Or(or Result[Type]) Result[Type] {
+	if r.failed {
+		return or
+	}
+
+	return r
+}
+
+// And performs a logical 'and' operation on the two given results. If the first
+// result is Ok, the second result is returned. Otherwise, the first result is
+// returned. This is almost equivalent to result.And. The difference is that
+// this method does not remap the error type. See also: Or.
+func (r Result[Type]) And(and Result[Type]) Result[Type] {
+	if r.failed {
+		return r
+	}
+
+	return and
+}
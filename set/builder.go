@@ -0,0 +1,50 @@
+package set
+
+import "github.com/gtramontina/go-extlib/internal/table"
+
+// Builder builds a Set by adding and removing members in place, sparing the
+// copies each Set.Add and Set.Remove would otherwise make. Much like
+// strings.Builder, it is meant for building up a Set in bulk before handing it
+// over. The zero value is ready to use.
+type Builder[Type any] struct {
+	members table.Builder[Type, struct{}]
+}
+
+// NewBuilder creates a Builder starting off with the members of the given
+// Set, which is left untouched.
+func NewBuilder[Type any](from Set[Type]) *Builder[Type] {
+	return &Builder[Type]{members: *table.NewBuilder(from.members)}
+}
+
+// Add adds the given member to the Set being built.
+func (b *Builder[Type]) Add(newMember Type) *Builder[Type] {
+	b.members.Put(newMember, struct{}{})
+
+	return b
+}
+
+// Remove removes the given member from the Set being built.
+func (b *Builder[Type]) Remove(existingMember Type) *Builder[Type] {
+	b.members.Remove(existingMember)
+
+	return b
+}
+
+// Contains checks whether the given element is a member of the Set being
+// built.
+func (b *Builder[Type]) Contains(member Type) bool {
+	_, contains := b.members.Get(member)
+
+	return contains
+}
+
+// Cardinality returns the number of members of the Set being built.
+func (b *Builder[Type]) Cardinality() int {
+	return b.members.Size()
+}
+
+// Freeze returns the Set built so far, without copying it. The Builder can
+// still be used afterwards without affecting the returned Set.
+func (b *Builder[Type]) Freeze() Set[Type] {
+	return Set[Type]{b.members.Freeze()}
+}
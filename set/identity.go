@@ -0,0 +1,17 @@
+package set
+
+import "github.com/gtramontina/go-extlib/internal/hash"
+
+// Hashable is implemented by types that define their own hash code, deciding
+// what identifies their values instead of having all of their fields
+// considered. Sets, as well as hashmap.HashMap keys, honour it. Types
+// implementing Hashable should also implement Equaler, so that values deemed
+// equal always share the same hash code.
+type Hashable = hash.Hashable
+
+// Equaler is implemented by types that define their own equality, telling
+// apart values whose hash codes collide. Sets, as well as hashmap.HashMap keys,
+// honour it. See also: Hashable.
+type Equaler[Type any] interface {
+	Equals(Type) bool
+}
@@ -0,0 +1,42 @@
+package set
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/gtramontina/go-extlib/internal/jsonorder"
+)
+
+// MarshalJSON encodes this Set as an array of its members, sorted so that equal
+// Sets are always encoded the same way. Numbers are sorted numerically and
+// strings alphabetically; any other members are sorted by their encoding.
+func (s Set[Type]) MarshalJSON() ([]byte, error) {
+	members := make([][]byte, 0, s.Cardinality())
+
+	for _, member := range s.list() {
+		encoded, err := json.Marshal(member)
+		if err != nil {
+			return nil, err //nolint:wrapcheck // surfacing the error as if encoding the member itself
+		}
+
+		members = append(members, encoded)
+	}
+
+	jsonorder.Sort(members, func(encoded []byte) []byte { return encoded })
+
+	return append(append([]byte{'['}, bytes.Join(members, []byte{','})...), ']'), nil
+}
+
+// UnmarshalJSON decodes an array into a Set of its elements. Duplicate elements
+// are allowed and only kept once. The decoded Set keeps the options this Set
+// was created with.
+func (s *Set[Type]) UnmarshalJSON(data []byte) error {
+	var members []Type
+	if err := json.Unmarshal(data, &members); err != nil {
+		return err //nolint:wrapcheck // surfacing the error as if decoding the array itself
+	}
+
+	*s = fromMembers(members, s.members.Options())
+
+	return nil
+}
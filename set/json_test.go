@@ -0,0 +1,63 @@
+package set_test
+
+import (
+	stdjson "encoding/json"
+	"testing"
+
+	"github.com/gtramontina/go-extlib/json"
+	"github.com/gtramontina/go-extlib/set"
+	"github.com/gtramontina/go-extlib/testing/assert"
+)
+
+func TestSetJSON(t *testing.T) {
+	encode := func(value any) string {
+		encoded, err := stdjson.Marshal(value)
+		assert.NoError(t, err)
+
+		return string(encoded)
+	}
+
+	t.Run("encodes as a sorted array", func(t *testing.T) {
+		assert.Eq(t, encode(set.New[int]()), `[]`)
+		assert.Eq(t, encode(set.New(10, 9, -1, 100)), `[-1,9,10,100]`)
+		assert.Eq(t, encode(set.New(0.5, 0.25)), `[0.25,0.5]`)
+		assert.Eq(t, encode(set.New("b", "a", "B")), `["B","a","b"]`)
+		assert.Eq(t, encode(set.New(true, false)), `[false,true]`)
+		assert.Eq(t, encode(set.New([]int{2}, []int{1, 2})), `[[1,2],[2]]`)
+	})
+
+	t.Run("encodes the same way every time", func(t *testing.T) {
+		members := set.New[int]()
+		for i := 0; i < 100; i++ {
+			members = members.Add(i)
+		}
+
+		expected := encode(members)
+		for i := 0; i < 10; i++ {
+			assert.Eq(t, encode(set.New(members.Iterator().Collect()...)), expected)
+		}
+	})
+
+	t.Run("decodes arrays", func(t *testing.T) {
+		assert.Equals(t, json.Unmarshal[set.Set[int]](`[]`), set.New[int]())
+		assert.Equals(t, json.Unmarshal[set.Set[int]](`[2,1,2]`), set.New(1, 2))
+		assert.Equals(t, json.Unmarshal[set.Set[string]](`["a","b"]`), set.New("a", "b"))
+
+		_, err := json.TryUnmarshal[set.Set[int]](`{"a":1}`)
+		assert.Error(t, err)
+	})
+
+	t.Run("decodes keeping the options of the set decoded into", func(t *testing.T) {
+		pointees := set.With[*int](set.FollowPointers())
+		assert.NoError(t, stdjson.Unmarshal([]byte(`[1,2,1]`), &pointees))
+		assert.Eq(t, pointees.Cardinality(), 2)
+	})
+
+	t.Run("round-trips", func(t *testing.T) {
+		type payload struct{ Tags set.Set[string] }
+
+		original := payload{set.New("x", "y", "z")}
+		decoded := json.Unmarshal[payload](encode(original))
+		assert.Equals(t, decoded.Tags, original.Tags)
+	})
+}
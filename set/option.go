@@ -0,0 +1,24 @@
+package set
+
+import (
+	"github.com/gtramontina/go-extlib/internal/hash"
+	"github.com/gtramontina/go-extlib/internal/table"
+)
+
+// Option customises how a Set tells its members apart.
+type Option = hash.Option
+
+// FollowPointers makes a Set tell pointer members apart by the values they
+// point to, rather than by their addresses. Nil pointers are only equal to
+// other nil pointers, and values referring back to themselves through pointers
+// are equal when their cycles go equally far back.
+func FollowPointers() Option {
+	return hash.FollowPointers()
+}
+
+// With creates an empty Set telling its members apart as the given options
+// describe. Sets derived from it, through Add, Union, Filter and the like, as
+// well as Builders starting off with it, keep its options.
+func With[Type any](options ...Option) Set[Type] {
+	return Set[Type]{table.With[Type, struct{}](options...)}
+}
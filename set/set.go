@@ -2,74 +2,79 @@ package set
 
 import (
 	"fmt"
+	"iter"
 	"reflect"
 	"sort"
 	"strings"
 
 	"github.com/gtramontina/go-extlib/internal/hash"
+	"github.com/gtramontina/go-extlib/internal/table"
+	"github.com/gtramontina/go-extlib/iterator"
 )
 
 // Set is a finite collection that contains no duplicate members. As implied by
-// its name, this type aims to model the mathematical concept of sets.
+// its name, this type aims to model the mathematical concept of sets. Members
+// are told apart by a hash code computed from their type and contents and,
+// when hash codes collide, by comparing them field by field. Pointers, channels
+// and functions are compared by address, although Sets created With
+// FollowPointers compare pointers by the values they point to. Member types may
+// define their own identity by implementing both Hashable and Equaler.
 type Set[Type any] struct {
-	members map[uint64]Type
+	members table.Table[Type, struct{}]
 }
 
 // New creates a Set containing the given members.
 func New[Type any](members ...Type) Set[Type] {
-	newMembers := make(map[uint64]Type, len(members))
-	for _, member := range members {
-		newMembers[hash.Calc(member)] = member
+	return fromMembers(members, nil)
+}
+
+// FromIterator creates a Set containing all remaining elements of the given
+// iterator.Iterator, consuming it, and telling them apart as the given options
+// describe.
+func FromIterator[Type any](members iterator.Iterator[Type], options ...Option) Set[Type] {
+	builder := NewBuilder(With[Type](options...))
+	for members.HasNext() {
+		builder.Add(members.Next())
 	}
 
-	return Set[Type]{newMembers}
+	return builder.Freeze()
 }
 
 // Add creates a Set containing all members of this Set plus the given new
 // member.
 func (s Set[Type]) Add(newMember Type) Set[Type] {
-	newMembers := make(map[uint64]Type, len(s.members)+1)
-	newMembers[hash.Calc(newMember)] = newMember
-
-	for h, member := range s.members {
-		newMembers[h] = member
-	}
-
-	return Set[Type]{newMembers}
+	return Set[Type]{s.members.Put(newMember, struct{}{})}
 }
 
 // Remove creates a Set containing all members of this Set minus the given
 // member.
 func (s Set[Type]) Remove(existingMember Type) Set[Type] {
-	newMembers := make(map[uint64]Type, len(s.members)-1)
-	existingMemberHash := hash.Calc(existingMember)
-
-	for h, member := range s.members {
-		if h != existingMemberHash {
-			newMembers[h] = member
-		}
-	}
-
-	return Set[Type]{newMembers}
+	return Set[Type]{s.members.Remove(existingMember)}
 }
 
 // Cardinality returns the number of members of this finite Set.
 //
 //	|A| or #A
 func (s Set[Type]) Cardinality() int {
-	return len(s.members)
+	return s.members.Size()
 }
 
 // Equals asserts whether this Set contains the exact same members as the other
 // Set.
 func (s Set[Type]) Equals(other Set[Type]) bool {
-	for h := range other.members {
-		if _, contains := s.members[h]; !contains {
-			return false
-		}
+	return s.Cardinality() == other.Cardinality() && s.SuperSetOf(other)
+}
+
+// Hash returns a hash code for this Set. Equal Sets share the same hash code,
+// regardless of the order their members were added in, which allows Sets to
+// be members of other Sets or keys of hashmap.HashMap.
+func (s Set[Type]) Hash() uint64 {
+	var calculatedHash uint64
+	for _, member := range s.list() {
+		calculatedHash += hash.Calc(member, s.members.Options()...)
 	}
 
-	return s.Cardinality() == other.Cardinality()
+	return calculatedHash
 }
 
 // Contains checks whether the given element is a member os this Set.
@@ -80,7 +85,7 @@ func (s Set[Type]) Equals(other Set[Type]) bool {
 //	 │             │
 //	 └─────────────┘
 func (s Set[Type]) Contains(member Type) bool {
-	_, contains := s.members[hash.Calc(member)]
+	_, contains := s.members.Get(member)
 
 	return contains
 }
@@ -88,8 +93,8 @@ func (s Set[Type]) Contains(member Type) bool {
 // SuperSetOf checks whether this Set is a super set of the given Set.
 // A ⊇ B.
 func (s Set[Type]) SuperSetOf(other Set[Type]) bool {
-	for h := range other.members {
-		if _, contains := s.members[h]; !contains {
+	for _, member := range other.list() {
+		if !s.Contains(member) {
 			return false
 		}
 	}
@@ -107,17 +112,7 @@ func (s Set[Type]) SuperSetOf(other Set[Type]) bool {
 //	      │#############│
 //	      └─────────────┘B
 func (s Set[Type]) Union(other Set[Type]) Set[Type] {
-	newMembers := make(map[uint64]Type, len(s.members)+len(other.members))
-
-	for h, member := range other.members {
-		newMembers[h] = member
-	}
-
-	for h, member := range s.members {
-		newMembers[h] = member
-	}
-
-	return Set[Type]{newMembers}
+	return fromMembers(append(other.list(), s.list()...), s.members.Options())
 }
 
 // Intersection creates a Set of all values that are members of both A and B.
@@ -130,15 +125,7 @@ func (s Set[Type]) Union(other Set[Type]) Set[Type] {
 //	      │             │
 //	      └─────────────┘B
 func (s Set[Type]) Intersection(other Set[Type]) Set[Type] {
-	newMembers := map[uint64]Type{}
-
-	for h, otherMember := range other.members {
-		if _, contains := s.members[h]; contains {
-			newMembers[h] = otherMember
-		}
-	}
-
-	return Set[Type]{newMembers}
+	return other.Filter(s.Contains)
 }
 
 // Difference creates a Set of all values of A that are not members of B.
@@ -151,15 +138,7 @@ func (s Set[Type]) Intersection(other Set[Type]) Set[Type] {
 //	      │             │
 //	      └─────────────┘B
 func (s Set[Type]) Difference(other Set[Type]) Set[Type] {
-	newMembers := map[uint64]Type{}
-
-	for h, member := range s.members {
-		if _, contains := other.members[h]; !contains {
-			newMembers[h] = member
-		}
-	}
-
-	return Set[Type]{newMembers}
+	return s.Filter(func(member Type) bool { return !other.Contains(member) })
 }
 
 // SymmetricDifference creates a Set of all values which are of one of the sets,
@@ -180,21 +159,36 @@ func (s Set[Type]) SymmetricDifference(other Set[Type]) Set[Type] {
 // and constructs a new Set of all the members for which the predicate returns
 // true.
 func (s Set[Type]) Filter(predicate func(Type) bool) Set[Type] {
-	newMembers := make(map[uint64]Type, len(s.members))
+	newMembers := make([]Type, 0, s.Cardinality())
 
-	for h, member := range s.members {
+	for _, member := range s.list() {
 		if predicate(member) {
-			newMembers[h] = member
+			newMembers = append(newMembers, member)
 		}
 	}
 
-	return Set[Type]{newMembers}
+	return fromMembers(newMembers, s.members.Options())
+}
+
+// Iterator returns an iterator.Iterator over all members of this Set in no
+// particular order. It walks through the members lazily, as they are asked
+// for.
+func (s Set[Type]) Iterator() iterator.Iterator[Type] {
+	return iterator.Map(s.members.All(), func(entry table.Entry[Type, struct{}]) Type { return entry.Key })
+}
+
+// All returns an iter.Seq over all members of this Set, in no particular order,
+// for use with range-over-func loops and the standard library.
+func (s Set[Type]) All() iter.Seq[Type] {
+	return func(yield func(Type) bool) {
+		iterator.Seq(s.Iterator())(yield)
+	}
 }
 
 // String renders itself as a string containing all members.
 func (s Set[Type]) String() string {
-	members := make([]string, 0, len(s.members))
-	for _, member := range s.members {
+	members := make([]string, 0, s.Cardinality())
+	for _, member := range s.list() {
 		members = append(members, fmt.Sprintf("%+v", member))
 	}
 
@@ -202,7 +196,27 @@ func (s Set[Type]) String() string {
 		return members[a] < members[z]
 	})
 
-	kind := reflect.TypeOf(s.members).Elem().String()
+	kind := reflect.TypeOf((*Type)(nil)).Elem().String()
 
 	return "Set(" + kind + "){" + strings.Join(members, ", ") + "}"
 }
+
+func fromMembers[Type any](members []Type, options []Option) Set[Type] {
+	builder := NewBuilder(With[Type](options...))
+	for _, member := range members {
+		builder.Add(member)
+	}
+
+	return builder.Freeze()
+}
+
+func (s Set[Type]) list() []Type {
+	entries := s.members.Entries()
+	members := make([]Type, 0, len(entries))
+
+	for _, entry := range entries {
+		members = append(members, entry.Key)
+	}
+
+	return members
+}
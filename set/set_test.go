@@ -1,12 +1,32 @@
 package set_test
 
 import (
+	"slices"
 	"testing"
 
+	"github.com/gtramontina/go-extlib/iterator"
 	"github.com/gtramontina/go-extlib/set"
 	"github.com/gtramontina/go-extlib/testing/assert"
 )
 
+// collidingMember hashes all of its values to the same hash code.
+type collidingMember string
+
+func (collidingMember) Hash() uint64 { return 0 }
+
+type account struct {
+	id    int
+	cache string
+}
+
+func (a account) Hash() uint64              { return uint64(a.id) }
+func (a account) Equals(other account) bool { return a.id == other.id }
+
+var (
+	_ set.Hashable         = account{}
+	_ set.Equaler[account] = account{}
+)
+
 func TestSet(t *testing.T) {
 	t.Run("empty sets never contain any members", func(t *testing.T) {
 		assert.False(t, set.New[int]().Contains(0))
@@ -189,6 +209,124 @@ func TestSet(t *testing.T) {
 		assert.NotEquals(t, set.New(person{"Jane Doe"}), set.New(person{"John Doe"}))
 	})
 
+	t.Run("keeps members with colliding hashes apart", func(t *testing.T) {
+		memberA, memberB := collidingMember("a"), collidingMember("b")
+		colliding := set.New(memberA, memberB)
+		assert.Eq(t, colliding.Cardinality(), 2)
+		assert.True(t, colliding.Contains(memberA))
+		assert.True(t, colliding.Contains(memberB))
+		assert.False(t, colliding.Contains(collidingMember("c")))
+		assert.Equals(t, colliding.Remove(memberA), set.New(memberB))
+		assert.Equals(t, set.New(memberA).Add(memberB), colliding)
+		assert.NotEquals(t, set.New(memberA), set.New(memberB))
+	})
+
+	t.Run("honours the identity members define for themselves", func(t *testing.T) {
+		accounts := set.New(account{1, "cached"}, account{1, ""}, account{2, ""})
+		assert.Eq(t, accounts.Cardinality(), 2)
+		assert.True(t, accounts.Contains(account{1, "stale"}))
+		assert.Equals(t, accounts.Remove(account{2, "stale"}), set.New(account{1, ""}))
+	})
+
+	t.Run("may have nil pointers to members defining their identity", func(t *testing.T) {
+		type holder struct{ account *account }
+
+		accounts := set.New[*account](nil, nil)
+		assert.Eq(t, accounts.Cardinality(), 1)
+		assert.True(t, accounts.Contains(nil))
+
+		holders := set.New(holder{nil}, holder{nil}, holder{&account{1, ""}})
+		assert.Eq(t, holders.Cardinality(), 2)
+		assert.True(t, holders.Contains(holder{nil}))
+	})
+
+	t.Run("may tell pointer members apart by the values they point to", func(t *testing.T) {
+		valueA, valueB, valueC := 1, 1, 2
+
+		assert.Eq(t, set.New(&valueA, &valueB).Cardinality(), 2)
+
+		pointees := set.With[*int](set.FollowPointers()).Add(&valueA).Add(&valueB).Add(&valueC)
+		assert.Eq(t, pointees.Cardinality(), 2)
+		assert.True(t, pointees.Contains(&valueB))
+		assert.Eq(t, pointees.Union(set.New(&valueB)).Cardinality(), 2)
+		assert.Eq(t, pointees.Filter(func(*int) bool { return true }).Add(&valueB).Cardinality(), 2)
+		assert.Eq(t, set.NewBuilder(pointees).Add(&valueB).Cardinality(), 2)
+		assert.Eq(t, pointees.Hash(), set.With[*int](set.FollowPointers()).Add(&valueB).Add(&valueC).Hash())
+	})
+
+	t.Run("may have self-referential members when following pointers", func(t *testing.T) {
+		type node struct {
+			value int
+			next  *node
+		}
+
+		ringOfOneA := &node{value: 1, next: nil}
+		ringOfOneA.next = ringOfOneA
+		ringOfOneB := &node{value: 1, next: nil}
+		ringOfOneB.next = ringOfOneB
+		ringOfTwo := &node{value: 1, next: &node{value: 1, next: nil}}
+		ringOfTwo.next.next = ringOfTwo
+
+		rings := set.With[*node](set.FollowPointers()).Add(ringOfOneA).Add(ringOfOneB).Add(ringOfTwo)
+		assert.Eq(t, rings.Cardinality(), 2)
+	})
+
+	t.Run("may have sets as members", func(t *testing.T) {
+		sets := set.New(set.New(0, 1), set.New(1, 0), set.New(2))
+		assert.Eq(t, sets.Cardinality(), 2)
+		assert.True(t, sets.Contains(set.New(0).Add(1)))
+		assert.False(t, sets.Contains(set.New(0)))
+		assert.Eq(t, set.New(0, 1).Hash(), set.New(1, 0).Hash())
+	})
+
+	t.Run("can be walked through lazily", func(t *testing.T) {
+		assert.False(t, set.New[int]().Iterator().HasNext())
+		assert.Equals(t, set.FromIterator(set.New(0, 1, 2).Iterator()), set.New(0, 1, 2))
+
+		iter := set.New(0).Iterator()
+		assert.True(t, iter.HasNext())
+		assert.Eq(t, iter.Next(), 0)
+		assert.False(t, iter.HasNext())
+	})
+
+	t.Run("can be ranged over", func(t *testing.T) {
+		assert.DeepEqual(t, slices.Sorted(set.New(2, 0, 1).All()), []int{0, 1, 2})
+		assert.DeepEqual(t, slices.Collect(set.New[int]().All()), []int(nil))
+
+		for member := range set.New(0, 1, 2).All() {
+			if member == 1 {
+				break
+			}
+		}
+	})
+
+	t.Run("can be created from iterators", func(t *testing.T) {
+		assert.Equals(t, set.FromIterator(iterator.From[int]()), set.New[int]())
+		assert.Equals(t, set.FromIterator(iterator.From(0, 1, 0, 2)), set.New(0, 1, 2))
+	})
+
+	t.Run("can be built in place", func(t *testing.T) {
+		var builder set.Builder[int]
+		assert.Equals(t, builder.Freeze(), set.New[int]())
+
+		builder.Add(0).Add(1).Add(2).Remove(1)
+		assert.Eq(t, builder.Cardinality(), 2)
+		assert.True(t, builder.Contains(0))
+		assert.False(t, builder.Contains(1))
+
+		frozen := builder.Freeze()
+		builder.Add(3).Remove(0)
+		assert.Equals(t, frozen, set.New(0, 2))
+		assert.Equals(t, builder.Freeze(), set.New(2, 3))
+
+		t.Run("does not mutate the set it starts off with", func(t *testing.T) {
+			original := set.New(0, 1)
+			extended := set.NewBuilder(original).Add(2).Remove(0).Freeze()
+			assert.Equals(t, original, set.New(0, 1))
+			assert.Equals(t, extended, set.New(1, 2))
+		})
+	})
+
 	t.Run("renders itself as string", func(t *testing.T) {
 		assert.Eq(t, set.New[int]().String(), "Set(int){}")
 		assert.Eq(t, set.New(0).String(), "Set(int){0}")
@@ -200,3 +338,49 @@ func TestSet(t *testing.T) {
 		assert.Eq(t, set.New(person{"Jane"}, person{"John"}).String(), `Set(set_test.person){{name:Jane}, {name:John}}`)
 	})
 }
+
+func BenchmarkSet(b *testing.B) {
+	const size = 100_000
+
+	b.Run("adding 100k members one by one", func(b *testing.B) {
+		b.ReportAllocs()
+
+		for n := 0; n < b.N; n++ {
+			members := set.New[int]()
+			for i := 0; i < size; i++ {
+				members = members.Add(i)
+			}
+		}
+	})
+
+	b.Run("removing 100k members one by one", func(b *testing.B) {
+		b.ReportAllocs()
+
+		full := set.New[int]()
+		for i := 0; i < size; i++ {
+			full = full.Add(i)
+		}
+
+		b.ResetTimer()
+
+		for n := 0; n < b.N; n++ {
+			members := full
+			for i := 0; i < size; i++ {
+				members = members.Remove(i)
+			}
+		}
+	})
+
+	b.Run("building 100k members in place", func(b *testing.B) {
+		b.ReportAllocs()
+
+		for n := 0; n < b.N; n++ {
+			var builder set.Builder[int]
+			for i := 0; i < size; i++ {
+				builder.Add(i)
+			}
+
+			_ = builder.Freeze()
+		}
+	})
+}
@@ -0,0 +1,58 @@
+package treemap
+
+import (
+	"errors"
+
+	"github.com/gtramontina/go-extlib/internal/jsonentries"
+	"github.com/gtramontina/go-extlib/internal/tree"
+)
+
+var (
+	// ErrInvalidJSON is returned when decoding JSON not shaped as a TreeMap.
+	ErrInvalidJSON = errors.New("treemap: expected an array of [key, value] pairs")
+
+	// ErrNoOrder is returned when decoding into a zero TreeMap whose keys have
+	// no natural order. Decode into a TreeMap created with NewBy instead.
+	ErrNoOrder = errors.New("treemap: keys have no natural order")
+)
+
+// MarshalJSON encodes this TreeMap as an object when its keys are strings, and
+// as an array of [key, value] pairs otherwise. Either way, entries are sorted by
+// key, as this TreeMap orders them.
+func (m TreeMap[Key, Value]) MarshalJSON() ([]byte, error) {
+	entries := make([]jsonentries.Encoded, 0, m.Size())
+
+	for _, entry := range m.entries.Entries() {
+		encoded, err := jsonentries.Encode(entry.Key, entry.Value)
+		if err != nil {
+			return nil, err //nolint:wrapcheck // surfacing the error as if encoding the entry here
+		}
+
+		entries = append(entries, encoded)
+	}
+
+	return jsonentries.Write[Key](entries), nil
+}
+
+// UnmarshalJSON decodes an object into a TreeMap when its keys are strings, and
+// an array of [key, value] pairs otherwise. When two entries hold equal keys,
+// the last one wins. The decoded TreeMap orders its keys the same way this
+// TreeMap does; a zero TreeMap orders them naturally, failing with ErrNoOrder
+// when they have no natural order.
+func (m *TreeMap[Key, Value]) UnmarshalJSON(data []byte) error {
+	less, ordered := m.entries.Order()
+	if !ordered {
+		return ErrNoOrder
+	}
+
+	entries := tree.New[Key, Value](less)
+
+	err := jsonentries.Decode(data, ErrInvalidJSON, func(key Key, value Value) { entries = entries.Put(key, value) })
+	if err != nil {
+		return err //nolint:wrapcheck // surfacing the error as if decoding the entries here
+	}
+
+	*m = TreeMap[Key, Value]{entries: entries}
+
+	return nil
+}
@@ -0,0 +1,82 @@
+package treemap_test
+
+import (
+	stdjson "encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/gtramontina/go-extlib/json"
+	"github.com/gtramontina/go-extlib/testing/assert"
+	"github.com/gtramontina/go-extlib/treemap"
+)
+
+func TestTreeMapJSON(t *testing.T) {
+	type point struct{ X, Y int }
+
+	encode := func(value any) string {
+		encoded, err := stdjson.Marshal(value)
+		assert.NoError(t, err)
+
+		return string(encoded)
+	}
+
+	byX := func(a, b point) bool { return a.X < b.X }
+
+	t.Run("encodes as an object sorted by key when keys are strings", func(t *testing.T) {
+		assert.Eq(t, encode(treemap.New[string, int]()), `{}`)
+		assert.Eq(t, encode(treemap.New(treemap.Pair("b", 2), treemap.Pair("a", 1))), `{"a":1,"b":2}`)
+
+		descending := treemap.NewBy(func(a, b string) bool { return a > b }, treemap.Pair("a", 1), treemap.Pair("b", 2))
+		assert.Eq(t, encode(descending), `{"b":2,"a":1}`)
+	})
+
+	t.Run("encodes as an array of pairs sorted by key otherwise", func(t *testing.T) {
+		assert.Eq(t, encode(treemap.New[int, string]()), `[]`)
+		assert.Eq(t, encode(treemap.New(treemap.Pair(10, "b"), treemap.Pair(9, "a"))), `[[9,"a"],[10,"b"]]`)
+		assert.Eq(t, encode(treemap.NewBy(byX, treemap.Pair(point{2, 0}, 1), treemap.Pair(point{1, 0}, 2))),
+			`[[{"X":1,"Y":0},2],[{"X":2,"Y":0},1]]`)
+	})
+
+	t.Run("decodes into keys ordered naturally", func(t *testing.T) {
+		assert.Equals(t, json.Unmarshal[treemap.TreeMap[string, int]](`{"b":2,"a":1}`),
+			treemap.New(treemap.Pair("a", 1), treemap.Pair("b", 2)))
+		assert.Equals(t, json.Unmarshal[treemap.TreeMap[int, string]](`[[2,"b"],[1,"a"],[2,"c"]]`),
+			treemap.New(treemap.Pair(1, "a"), treemap.Pair(2, "c")))
+		assert.DeepEqual(t, json.Unmarshal[treemap.TreeMap[float64, string]](`[[2.5,"b"],[-1,"a"]]`).Keys(),
+			[]float64{-1, 2.5})
+	})
+
+	t.Run("decodes keeping the order of the tree map decoded into", func(t *testing.T) {
+		descending := treemap.NewBy[string, int](func(a, b string) bool { return a > b })
+		assert.NoError(t, stdjson.Unmarshal([]byte(`{"a":1,"c":3,"b":2}`), &descending))
+		assert.DeepEqual(t, descending.Keys(), []string{"c", "b", "a"})
+
+		points := treemap.NewBy[point, int](byX)
+		assert.NoError(t, stdjson.Unmarshal([]byte(`[[{"X":2},1],[{"X":1},2]]`), &points))
+		assert.DeepEqual(t, points.Keys(), []point{{1, 0}, {2, 0}})
+	})
+
+	t.Run("round-trips", func(t *testing.T) {
+		type payload struct {
+			Counts treemap.TreeMap[string, int]
+			Names  treemap.TreeMap[int, string]
+		}
+
+		original := payload{treemap.New(treemap.Pair("a", 1)), treemap.New(treemap.Pair(1, "a"))}
+		decoded := json.Unmarshal[payload](encode(original))
+		assert.Equals(t, decoded.Counts, original.Counts)
+		assert.Equals(t, decoded.Names, original.Names)
+	})
+
+	t.Run("fails decoding keys without an order", func(t *testing.T) {
+		_, err := json.TryUnmarshal[treemap.TreeMap[point, int]](`[[{"X":1},1]]`)
+		assert.True(t, errors.Is(err, treemap.ErrNoOrder))
+	})
+
+	t.Run("fails decoding anything but arrays of pairs when keys are not strings", func(t *testing.T) {
+		for _, invalid := range []string{`{"1":"a"}`, `[[1]]`, `[[1,"a",2]]`, `[1]`} {
+			_, err := json.TryUnmarshal[treemap.TreeMap[int, string]](invalid)
+			assert.True(t, errors.Is(err, treemap.ErrInvalidJSON), invalid)
+		}
+	})
+}
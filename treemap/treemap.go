@@ -0,0 +1,197 @@
+package treemap
+
+import (
+	"iter"
+
+	"github.com/gtramontina/go-extlib/internal/hash"
+	"github.com/gtramontina/go-extlib/internal/tree"
+	"github.com/gtramontina/go-extlib/iterator"
+	"github.com/gtramontina/go-extlib/maybe"
+	"golang.org/x/exp/constraints"
+)
+
+// TreeMap implements a structure that maps keys to values, keeping its entries
+// sorted by key. Besides looking values up, it answers questions about the
+// order of its keys, like which is the first or which come between two others.
+// Keys are ordered either naturally (New) or by a given less function (NewBy);
+// two keys are deemed equal when neither is less than the other. It is backed
+// by a persistent balanced tree, so updates create new TreeMaps sharing most
+// of their structure with the original.
+type TreeMap[Key any, Value any] struct {
+	entries tree.Tree[Key, Value]
+}
+
+// New creates a new TreeMap containing the given key/value entry pairs, sorted
+// by the natural order of their keys.
+func New[Key constraints.Ordered, Value any](entries ...Entry[Key, Value]) TreeMap[Key, Value] {
+	return NewBy(tree.Ascending[Key], entries...)
+}
+
+// NewBy creates a new TreeMap containing the given key/value entry pairs,
+// sorted by the given less function. This function must return a boolean
+// indicating whether the first argument should come before the second
+// argument, like the one collections.SortBy takes.
+func NewBy[Key any, Value any](less func(Key, Key) bool, entries ...Entry[Key, Value]) TreeMap[Key, Value] {
+	newEntries := tree.New[Key, Value](less)
+	for _, entry := range entries {
+		newEntries = newEntries.Put(entry.key, entry.value)
+	}
+
+	return TreeMap[Key, Value]{entries: newEntries}
+}
+
+// Size returns the number of key/value pair entries this TreeMap holds.
+func (m TreeMap[Key, Value]) Size() int {
+	return m.entries.Size()
+}
+
+// Empty returns true when this TreeMap does not hold any entries; false
+// otherwise.
+func (m TreeMap[Key, Value]) Empty() bool {
+	return m.Size() == 0
+}
+
+// Put creates a new TreeMap containing all existing entries plus the newly
+// given key/value pair. If an entry for the given Key already exists, its value
+// is replaced with the given Value.
+func (m TreeMap[Key, Value]) Put(key Key, value Value) TreeMap[Key, Value] {
+	return TreeMap[Key, Value]{entries: m.entries.Put(key, value)}
+}
+
+// Remove creates a new TreeMap containing all existing entries but the one
+// whose key matches the given Key. If none is found, the resulting TreeMap is
+// equal to the original.
+func (m TreeMap[Key, Value]) Remove(key Key) TreeMap[Key, Value] {
+	return TreeMap[Key, Value]{entries: m.entries.Remove(key)}
+}
+
+// MaybeGet retrieves the Value for the given Key. Returns a Maybe[Value] type.
+// Please refer to maybe.Maybe documentation for more information.
+func (m TreeMap[Key, Value]) MaybeGet(key Key) maybe.Maybe[Value] {
+	value, ok := m.entries.Get(key)
+	if !ok {
+		return maybe.None[Value]()
+	}
+
+	return maybe.Some(value)
+}
+
+// HasKey returns true if this TreeMap contains a Value for the given Key; false
+// otherwise.
+func (m TreeMap[Key, Value]) HasKey(key Key) bool {
+	_, has := m.entries.Get(key)
+
+	return has
+}
+
+// First retrieves the entry holding the least key, if any.
+func (m TreeMap[Key, Value]) First() maybe.Maybe[Entry[Key, Value]] {
+	return fromTree(m.entries.First())
+}
+
+// Last retrieves the entry holding the greatest key, if any.
+func (m TreeMap[Key, Value]) Last() maybe.Maybe[Entry[Key, Value]] {
+	return fromTree(m.entries.Last())
+}
+
+// Floor retrieves the entry holding the greatest key less than or equal to the
+// given Key, if any.
+func (m TreeMap[Key, Value]) Floor(key Key) maybe.Maybe[Entry[Key, Value]] {
+	return fromTree(m.entries.Floor(key))
+}
+
+// Ceiling retrieves the entry holding the least key greater than or equal to
+// the given Key, if any.
+func (m TreeMap[Key, Value]) Ceiling(key Key) maybe.Maybe[Entry[Key, Value]] {
+	return fromTree(m.entries.Ceiling(key))
+}
+
+// Range returns an iterator.Iterator over the entries whose keys are greater
+// than or equal to from and less than until, sorted by key.
+//
+//	[from, until)
+func (m TreeMap[Key, Value]) Range(from Key, until Key) iterator.Iterator[Entry[Key, Value]] {
+	return iterator.Map(m.entries.Range(from, until), toEntry[Key, Value])
+}
+
+// Iterator returns an iterator.Iterator over all entries, sorted by key.
+func (m TreeMap[Key, Value]) Iterator() iterator.Iterator[Entry[Key, Value]] {
+	return iterator.Map(m.entries.All(), toEntry[Key, Value])
+}
+
+// All returns an iter.Seq2 over all keys and values contained in this TreeMap,
+// sorted by key, for use with range-over-func loops and the standard library.
+func (m TreeMap[Key, Value]) All() iter.Seq2[Key, Value] {
+	return func(yield func(Key, Value) bool) {
+		for entries := m.entries.All(); entries.HasNext(); {
+			if entry := entries.Next(); !yield(entry.Key, entry.Value) {
+				return
+			}
+		}
+	}
+}
+
+// Keys returns all keys contained in this TreeMap, sorted.
+func (m TreeMap[Key, Value]) Keys() []Key {
+	keys := make([]Key, 0, m.Size())
+	for _, entry := range m.entries.Entries() {
+		keys = append(keys, entry.Key)
+	}
+
+	return keys
+}
+
+// Values returns all values contained in this TreeMap, sorted by their keys.
+func (m TreeMap[Key, Value]) Values() []Value {
+	values := make([]Value, 0, m.Size())
+	for _, entry := range m.entries.Entries() {
+		values = append(values, entry.Value)
+	}
+
+	return values
+}
+
+// Equals compares this TreeMap with another TreeMap. Returns true when all keys
+// and values are the same; false otherwise. Keys are compared by the less
+// function of this TreeMap.
+func (m TreeMap[Key, Value]) Equals(other TreeMap[Key, Value]) bool {
+	if m.Size() != other.Size() {
+		return false
+	}
+
+	mine, others := m.entries.All(), other.entries.All()
+	for mine.HasNext() {
+		entry, otherEntry := mine.Next(), others.Next()
+		if m.entries.Less(entry.Key, otherEntry.Key) || m.entries.Less(otherEntry.Key, entry.Key) ||
+			!hash.Equal(entry.Value, otherEntry.Value) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Hash returns a hash code for this TreeMap, allowing TreeMaps to be keys of
+// hashmap.HashMap or members of set.Set. Equal TreeMaps share the same hash
+// code as long as keys their less function deems equal are also equal field by
+// field, or by their own Equals method when they define one.
+func (m TreeMap[Key, Value]) Hash() uint64 {
+	var calculatedHash uint64
+	for _, entry := range m.entries.Entries() {
+		calculatedHash += hash.Calc(Pair(entry.Key, entry.Value))
+	}
+
+	return calculatedHash
+}
+
+func toEntry[Key any, Value any](entry tree.Entry[Key, Value]) Entry[Key, Value] {
+	return Pair(entry.Key, entry.Value)
+}
+
+func fromTree[Key any, Value any](entry tree.Entry[Key, Value], found bool) maybe.Maybe[Entry[Key, Value]] {
+	if !found {
+		return maybe.None[Entry[Key, Value]]()
+	}
+
+	return maybe.Some(toEntry(entry))
+}
@@ -0,0 +1,103 @@
+package treemap_test
+
+import (
+	"maps"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gtramontina/go-extlib/hashmap"
+	"github.com/gtramontina/go-extlib/maybe"
+	"github.com/gtramontina/go-extlib/testing/assert"
+	"github.com/gtramontina/go-extlib/treemap"
+)
+
+func TestTreeMap(t *testing.T) {
+	filled := treemap.New(treemap.Pair("b", 2), treemap.Pair("c", 3), treemap.Pair("a", 1))
+
+	t.Run("is comparable to other tree maps", func(t *testing.T) {
+		assert.Equals(t, treemap.New[string, int](), treemap.New[string, int]())
+		assert.Equals(t, filled, treemap.New(treemap.Pair("a", 1), treemap.Pair("b", 2), treemap.Pair("c", 3)))
+		assert.NotEquals(t, filled, treemap.New(treemap.Pair("a", 1), treemap.Pair("b", 2)))
+		assert.NotEquals(t, filled, treemap.New(treemap.Pair("a", 1), treemap.Pair("b", 2), treemap.Pair("c", 0)))
+		assert.NotEquals(t, filled, treemap.New(treemap.Pair("a", 1), treemap.Pair("b", 2), treemap.Pair("d", 3)))
+	})
+
+	t.Run("allows putting and removing entries", func(t *testing.T) {
+		assert.Equals(t, treemap.New[string, int]().Put("a", 1), treemap.New(treemap.Pair("a", 1)))
+		assert.Equals(t, filled.Put("a", 0), treemap.New(treemap.Pair("a", 0), treemap.Pair("b", 2), treemap.Pair("c", 3)))
+		assert.Equals(t, filled.Remove("b"), treemap.New(treemap.Pair("a", 1), treemap.Pair("c", 3)))
+		assert.Equals(t, filled.Remove("nope"), filled)
+
+		t.Run("does not mutate the tree map", func(t *testing.T) {
+			assert.Eq(t, filled.Size(), 3)
+			assert.DeepEqual(t, filled.MaybeGet("a"), maybe.Some(1))
+		})
+	})
+
+	t.Run("can retrieve Maybe values based on keys", func(t *testing.T) {
+		assert.DeepEqual(t, filled.MaybeGet("a"), maybe.Some(1))
+		assert.DeepEqual(t, filled.MaybeGet("c"), maybe.Some(3))
+		assert.DeepEqual(t, filled.MaybeGet("nope"), maybe.None[int]())
+		assert.True(t, filled.HasKey("b"))
+		assert.False(t, filled.HasKey("nope"))
+		assert.True(t, treemap.New[string, int]().Empty())
+		assert.False(t, filled.Empty())
+	})
+
+	t.Run("retrieves entries based on the order of keys", func(t *testing.T) {
+		assert.DeepEqual(t, filled.First(), maybe.Some(treemap.Pair("a", 1)))
+		assert.DeepEqual(t, filled.Last(), maybe.Some(treemap.Pair("c", 3)))
+		assert.DeepEqual(t, treemap.New[string, int]().First(), maybe.None[treemap.Entry[string, int]]())
+		assert.DeepEqual(t, treemap.New[string, int]().Last(), maybe.None[treemap.Entry[string, int]]())
+		assert.DeepEqual(t, filled.Floor("bb"), maybe.Some(treemap.Pair("b", 2)))
+		assert.DeepEqual(t, filled.Floor("b"), maybe.Some(treemap.Pair("b", 2)))
+		assert.DeepEqual(t, filled.Floor("0"), maybe.None[treemap.Entry[string, int]]())
+		assert.DeepEqual(t, filled.Ceiling("bb"), maybe.Some(treemap.Pair("c", 3)))
+		assert.DeepEqual(t, filled.Ceiling("b"), maybe.Some(treemap.Pair("b", 2)))
+		assert.DeepEqual(t, filled.Ceiling("d"), maybe.None[treemap.Entry[string, int]]())
+	})
+
+	t.Run("iterates over entries sorted by key", func(t *testing.T) {
+		assert.DeepEqual(t, filled.Iterator().Collect(), []treemap.Entry[string, int]{
+			treemap.Pair("a", 1), treemap.Pair("b", 2), treemap.Pair("c", 3),
+		})
+		assert.DeepEqual(t, filled.Keys(), []string{"a", "b", "c"})
+		assert.DeepEqual(t, filled.Values(), []int{1, 2, 3})
+	})
+
+	t.Run("ranges over entries sorted by key", func(t *testing.T) {
+		var ranged []string
+		for key, value := range filled.All() {
+			ranged = append(ranged, key+strconv.Itoa(value))
+		}
+
+		assert.DeepEqual(t, ranged, []string{"a1", "b2", "c3"})
+		assert.DeepEqual(t, maps.Collect(filled.All()), map[string]int{"a": 1, "b": 2, "c": 3})
+	})
+
+	t.Run("iterates over ranges of keys", func(t *testing.T) {
+		assert.DeepEqual(t, filled.Range("a", "c").Collect(), []treemap.Entry[string, int]{
+			treemap.Pair("a", 1), treemap.Pair("b", 2),
+		})
+		assert.DeepEqual(t, filled.Range("aa", "z").Collect(), []treemap.Entry[string, int]{
+			treemap.Pair("b", 2), treemap.Pair("c", 3),
+		})
+		assert.DeepEqual(t, filled.Range("x", "z").Collect(), []treemap.Entry[string, int]{})
+	})
+
+	t.Run("orders keys by the given less function", func(t *testing.T) {
+		caseInsensitive := func(a, b string) bool { return strings.ToLower(a) < strings.ToLower(b) }
+		byName := treemap.NewBy(caseInsensitive, treemap.Pair("b", 1), treemap.Pair("A", 2), treemap.Pair("a", 3))
+		assert.Eq(t, byName.Size(), 2)
+		assert.DeepEqual(t, byName.Keys(), []string{"a", "b"})
+		assert.DeepEqual(t, byName.MaybeGet("A"), maybe.Some(3))
+	})
+
+	t.Run("may be a key of hash maps", func(t *testing.T) {
+		reordered := treemap.New(treemap.Pair("c", 3), treemap.Pair("a", 1), treemap.Pair("b", 2))
+		nested := hashmap.New(hashmap.Pair(filled, "first")).Put(reordered, "second")
+		assert.Eq(t, nested.Size(), 1)
+		assert.Eq(t, filled.Hash(), reordered.Hash())
+	})
+}
@@ -0,0 +1,48 @@
+package treeset
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/gtramontina/go-extlib/internal/tree"
+)
+
+// ErrNoOrder is returned when decoding into a zero TreeSet whose members have
+// no natural order. Decode into a TreeSet created with NewBy instead.
+var ErrNoOrder = errors.New("treeset: members have no natural order")
+
+// MarshalJSON encodes this TreeSet as an array of its members, sorted as this
+// TreeSet orders them.
+func (s TreeSet[Type]) MarshalJSON() ([]byte, error) {
+	members := make([]Type, 0, s.Cardinality())
+	for _, entry := range s.members.Entries() {
+		members = append(members, entry.Key)
+	}
+
+	return json.Marshal(members) //nolint:wrapcheck // surfacing the error as if encoding the members here
+}
+
+// UnmarshalJSON decodes an array into a TreeSet of its elements. Duplicate
+// elements are allowed and only kept once. The decoded TreeSet orders its
+// members the same way this TreeSet does; a zero TreeSet orders them naturally,
+// failing with ErrNoOrder when they have no natural order.
+func (s *TreeSet[Type]) UnmarshalJSON(data []byte) error {
+	less, ordered := s.members.Order()
+	if !ordered {
+		return ErrNoOrder
+	}
+
+	var elements []Type
+	if err := json.Unmarshal(data, &elements); err != nil {
+		return err //nolint:wrapcheck // surfacing the error as if decoding the array itself
+	}
+
+	members := tree.New[Type, struct{}](less)
+	for _, element := range elements {
+		members = members.Put(element, struct{}{})
+	}
+
+	*s = TreeSet[Type]{members}
+
+	return nil
+}
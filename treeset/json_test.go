@@ -0,0 +1,54 @@
+package treeset_test
+
+import (
+	stdjson "encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/gtramontina/go-extlib/json"
+	"github.com/gtramontina/go-extlib/testing/assert"
+	"github.com/gtramontina/go-extlib/treeset"
+)
+
+func TestTreeSetJSON(t *testing.T) {
+	encode := func(value any) string {
+		encoded, err := stdjson.Marshal(value)
+		assert.NoError(t, err)
+
+		return string(encoded)
+	}
+
+	t.Run("encodes as a sorted array", func(t *testing.T) {
+		assert.Eq(t, encode(treeset.New[int]()), `[]`)
+		assert.Eq(t, encode(treeset.New(3, 1, 2)), `[1,2,3]`)
+		assert.Eq(t, encode(treeset.NewBy(func(a, b string) bool { return a > b }, "a", "c", "b")), `["c","b","a"]`)
+	})
+
+	t.Run("decodes arrays into members ordered naturally", func(t *testing.T) {
+		assert.Equals(t, json.Unmarshal[treeset.TreeSet[int]](`[2,1,2]`), treeset.New(1, 2))
+		assert.Equals(t, json.Unmarshal[treeset.TreeSet[string]](`["b","a"]`), treeset.New("a", "b"))
+	})
+
+	t.Run("decodes keeping the order of the tree set decoded into", func(t *testing.T) {
+		descending := treeset.NewBy[int](func(a, b int) bool { return a > b })
+		assert.NoError(t, stdjson.Unmarshal([]byte(`[1,3,2]`), &descending))
+		assert.Eq(t, encode(descending), `[3,2,1]`)
+	})
+
+	t.Run("round-trips", func(t *testing.T) {
+		type payload struct{ Tags treeset.TreeSet[string] }
+
+		original := payload{treeset.New("z", "x", "y")}
+		encoded := encode(original)
+		assert.Eq(t, encoded, `{"Tags":["x","y","z"]}`)
+		assert.Equals(t, json.Unmarshal[payload](encoded).Tags, original.Tags)
+	})
+
+	t.Run("fails decoding members without an order", func(t *testing.T) {
+		_, err := json.TryUnmarshal[treeset.TreeSet[struct{ X int }]](`[{"X":1}]`)
+		assert.True(t, errors.Is(err, treeset.ErrNoOrder))
+
+		_, err = json.TryUnmarshal[treeset.TreeSet[int]](`{"a":1}`)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,166 @@
+package treeset
+
+import (
+	"fmt"
+	"iter"
+	"reflect"
+	"strings"
+
+	"github.com/gtramontina/go-extlib/internal/hash"
+	"github.com/gtramontina/go-extlib/internal/tree"
+	"github.com/gtramontina/go-extlib/iterator"
+	"github.com/gtramontina/go-extlib/maybe"
+	"golang.org/x/exp/constraints"
+)
+
+// TreeSet is a finite collection that contains no duplicate members, keeping
+// them sorted. Besides telling whether it contains a member, it answers
+// questions about the order of its members, like which is the first or which
+// come between two others. Members are ordered either naturally (New) or by a
+// given less function (NewBy); two members are deemed equal when neither is
+// less than the other. It is backed by a persistent balanced tree, so updates
+// create new TreeSets sharing most of their structure with the original.
+type TreeSet[Type any] struct {
+	members tree.Tree[Type, struct{}]
+}
+
+// New creates a TreeSet containing the given members, sorted by their natural
+// order.
+func New[Type constraints.Ordered](members ...Type) TreeSet[Type] {
+	return NewBy(tree.Ascending[Type], members...)
+}
+
+// NewBy creates a TreeSet containing the given members, sorted by the given
+// less function. This function must return a boolean indicating whether the
+// first argument should come before the second argument, like the one
+// collections.SortBy takes.
+func NewBy[Type any](less func(Type, Type) bool, members ...Type) TreeSet[Type] {
+	newMembers := tree.New[Type, struct{}](less)
+	for _, member := range members {
+		newMembers = newMembers.Put(member, struct{}{})
+	}
+
+	return TreeSet[Type]{newMembers}
+}
+
+// Add creates a TreeSet containing all members of this TreeSet plus the given
+// new member.
+func (s TreeSet[Type]) Add(newMember Type) TreeSet[Type] {
+	return TreeSet[Type]{s.members.Put(newMember, struct{}{})}
+}
+
+// Remove creates a TreeSet containing all members of this TreeSet minus the
+// given member.
+func (s TreeSet[Type]) Remove(existingMember Type) TreeSet[Type] {
+	return TreeSet[Type]{s.members.Remove(existingMember)}
+}
+
+// Cardinality returns the number of members of this finite TreeSet.
+func (s TreeSet[Type]) Cardinality() int {
+	return s.members.Size()
+}
+
+// Contains checks whether the given element is a member of this TreeSet.
+func (s TreeSet[Type]) Contains(member Type) bool {
+	_, contains := s.members.Get(member)
+
+	return contains
+}
+
+// First retrieves the least member, if any.
+func (s TreeSet[Type]) First() maybe.Maybe[Type] {
+	return fromTree(s.members.First())
+}
+
+// Last retrieves the greatest member, if any.
+func (s TreeSet[Type]) Last() maybe.Maybe[Type] {
+	return fromTree(s.members.Last())
+}
+
+// Floor retrieves the greatest member less than or equal to the given element,
+// if any.
+func (s TreeSet[Type]) Floor(element Type) maybe.Maybe[Type] {
+	return fromTree(s.members.Floor(element))
+}
+
+// Ceiling retrieves the least member greater than or equal to the given
+// element, if any.
+func (s TreeSet[Type]) Ceiling(element Type) maybe.Maybe[Type] {
+	return fromTree(s.members.Ceiling(element))
+}
+
+// Range returns an iterator.Iterator over the members greater than or equal to
+// from and less than until, sorted.
+//
+//	[from, until)
+func (s TreeSet[Type]) Range(from Type, until Type) iterator.Iterator[Type] {
+	return iterator.Map(s.members.Range(from, until), toMember[Type])
+}
+
+// Iterator returns an iterator.Iterator over all members, sorted.
+func (s TreeSet[Type]) Iterator() iterator.Iterator[Type] {
+	return iterator.Map(s.members.All(), toMember[Type])
+}
+
+// All returns an iter.Seq over all members of this TreeSet, sorted, for use
+// with range-over-func loops and the standard library.
+func (s TreeSet[Type]) All() iter.Seq[Type] {
+	return func(yield func(Type) bool) {
+		iterator.Seq(s.Iterator())(yield)
+	}
+}
+
+// Equals asserts whether this TreeSet contains the exact same members as the
+// other TreeSet. Members are compared by the less function of this TreeSet.
+func (s TreeSet[Type]) Equals(other TreeSet[Type]) bool {
+	if s.Cardinality() != other.Cardinality() {
+		return false
+	}
+
+	mine, others := s.members.All(), other.members.All()
+	for mine.HasNext() {
+		member, otherMember := mine.Next().Key, others.Next().Key
+		if s.members.Less(member, otherMember) || s.members.Less(otherMember, member) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Hash returns a hash code for this TreeSet, allowing TreeSets to be members of
+// set.Set or keys of hashmap.HashMap. Equal TreeSets share the same hash code as
+// long as members their less function deems equal are also equal field by
+// field, or by their own Equals method when they define one.
+func (s TreeSet[Type]) Hash() uint64 {
+	var calculatedHash uint64
+	for _, entry := range s.members.Entries() {
+		calculatedHash += hash.Calc(entry.Key)
+	}
+
+	return calculatedHash
+}
+
+// String renders itself as a string containing all members, sorted.
+func (s TreeSet[Type]) String() string {
+	members := make([]string, 0, s.Cardinality())
+	for _, entry := range s.members.Entries() {
+		members = append(members, fmt.Sprintf("%+v", entry.Key))
+	}
+
+	kind := reflect.TypeOf((*Type)(nil)).Elem().String()
+
+	return "TreeSet(" + kind + "){" + strings.Join(members, ", ") + "}"
+}
+
+func toMember[Type any](entry tree.Entry[Type, struct{}]) Type {
+	return entry.Key
+}
+
+func fromTree[Type any](entry tree.Entry[Type, struct{}], found bool) maybe.Maybe[Type] {
+	if !found {
+		return maybe.None[Type]()
+	}
+
+	return maybe.Some(entry.Key)
+}
@@ -0,0 +1,94 @@
+package treeset_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/gtramontina/go-extlib/maybe"
+	"github.com/gtramontina/go-extlib/set"
+	"github.com/gtramontina/go-extlib/testing/assert"
+	"github.com/gtramontina/go-extlib/treeset"
+)
+
+func TestTreeSet(t *testing.T) {
+	filled := treeset.New(20, 0, 10, 30)
+
+	t.Run("is comparable to other tree sets", func(t *testing.T) {
+		assert.Equals(t, treeset.New[int](), treeset.New[int]())
+		assert.Equals(t, filled, treeset.New(0, 10, 20, 30))
+		assert.Equals(t, treeset.New(0, 0, 1), treeset.New(1, 0))
+		assert.NotEquals(t, filled, treeset.New(0, 10, 20))
+		assert.NotEquals(t, filled, treeset.New(0, 10, 20, 40))
+	})
+
+	t.Run("allows adding and removing members", func(t *testing.T) {
+		assert.Equals(t, filled.Add(5), treeset.New(0, 5, 10, 20, 30))
+		assert.Equals(t, filled.Add(10), filled)
+		assert.Equals(t, filled.Remove(10), treeset.New(0, 20, 30))
+		assert.Equals(t, filled.Remove(15), filled)
+
+		t.Run("does not mutate the tree set", func(t *testing.T) {
+			assert.Equals(t, filled, treeset.New(0, 10, 20, 30))
+		})
+	})
+
+	t.Run("tells whether it contains members", func(t *testing.T) {
+		assert.Eq(t, filled.Cardinality(), 4)
+		assert.True(t, filled.Contains(10))
+		assert.False(t, filled.Contains(15))
+		assert.False(t, treeset.New[int]().Contains(0))
+	})
+
+	t.Run("retrieves members based on their order", func(t *testing.T) {
+		assert.DeepEqual(t, filled.First(), maybe.Some(0))
+		assert.DeepEqual(t, filled.Last(), maybe.Some(30))
+		assert.DeepEqual(t, treeset.New[int]().First(), maybe.None[int]())
+		assert.DeepEqual(t, treeset.New[int]().Last(), maybe.None[int]())
+		assert.DeepEqual(t, filled.Floor(15), maybe.Some(10))
+		assert.DeepEqual(t, filled.Floor(10), maybe.Some(10))
+		assert.DeepEqual(t, filled.Floor(-1), maybe.None[int]())
+		assert.DeepEqual(t, filled.Ceiling(15), maybe.Some(20))
+		assert.DeepEqual(t, filled.Ceiling(20), maybe.Some(20))
+		assert.DeepEqual(t, filled.Ceiling(31), maybe.None[int]())
+	})
+
+	t.Run("iterates over members in order", func(t *testing.T) {
+		assert.DeepEqual(t, filled.Iterator().Collect(), []int{0, 10, 20, 30})
+		assert.DeepEqual(t, filled.Range(5, 30).Collect(), []int{10, 20})
+		assert.DeepEqual(t, filled.Range(10, 11).Collect(), []int{10})
+		assert.DeepEqual(t, filled.Range(31, 40).Collect(), []int{})
+	})
+
+	t.Run("ranges over members in order", func(t *testing.T) {
+		assert.DeepEqual(t, slices.Collect(filled.All()), []int{0, 10, 20, 30})
+
+		var ranged []int
+		for member := range filled.All() {
+			if member > 10 {
+				break
+			}
+
+			ranged = append(ranged, member)
+		}
+
+		assert.DeepEqual(t, ranged, []int{0, 10})
+	})
+
+	t.Run("orders members by the given less function", func(t *testing.T) {
+		descending := treeset.NewBy(func(a, b int) bool { return a > b }, 1, 3, 2)
+		assert.DeepEqual(t, descending.Iterator().Collect(), []int{3, 2, 1})
+		assert.DeepEqual(t, descending.First(), maybe.Some(3))
+		assert.DeepEqual(t, descending.Range(3, 1).Collect(), []int{3, 2})
+	})
+
+	t.Run("may be a member of sets", func(t *testing.T) {
+		sets := set.New(filled, treeset.New(30, 20, 10, 0), treeset.New(1))
+		assert.Eq(t, sets.Cardinality(), 2)
+	})
+
+	t.Run("renders itself as string", func(t *testing.T) {
+		assert.Eq(t, treeset.New[int]().String(), "TreeSet(int){}")
+		assert.Eq(t, filled.String(), "TreeSet(int){0, 10, 20, 30}")
+		assert.Eq(t, treeset.New("b", "a").String(), "TreeSet(string){a, b}")
+	})
+}